@@ -0,0 +1,13 @@
+data "contentful_webhook_calls" "deploy" {
+  space_id   = contentful_webhook.deploy.space_id
+  webhook_id = contentful_webhook.deploy.id
+}
+
+check "webhook_delivers" {
+  assert {
+    condition = alltrue([
+      for call in data.contentful_webhook_calls.deploy.calls : call.status_code >= 200 && call.status_code < 300
+    ])
+    error_message = "The deploy webhook received unsuccessful responses"
+  }
+}
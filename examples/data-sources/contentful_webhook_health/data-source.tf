@@ -0,0 +1,8 @@
+data "contentful_webhook_health" "deploy" {
+  space_id   = contentful_webhook.deploy.space_id
+  webhook_id = contentful_webhook.deploy.id
+}
+
+output "webhook_healthy" {
+  value = data.contentful_webhook_health.deploy.healthy_calls == data.contentful_webhook_health.deploy.total_calls
+}
@@ -0,0 +1,4 @@
+ephemeral "contentful_api_key_token" "delivery" {
+  space_id   = "space-id"
+  api_key_id = contentful_apikey.myapikey.id
+}
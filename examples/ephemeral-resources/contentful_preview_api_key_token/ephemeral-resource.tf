@@ -0,0 +1,4 @@
+ephemeral "contentful_preview_api_key_token" "preview" {
+  space_id           = "space-id"
+  preview_api_key_id = contentful_apikey.myapikey.preview_id
+}
@@ -0,0 +1,21 @@
+resource "contentful_entry" "article" {
+  entry_id       = "welcome"
+  space_id       = contentful_space.example.id
+  environment    = "master"
+  contenttype_id = "article"
+  published      = true
+
+  field {
+    id      = "title"
+    locale  = "en-US"
+    content = "Welcome"
+  }
+
+  field {
+    id     = "body"
+    locale = "en-US"
+    content = provider::contentful::richtext_from_html(
+      "<h2>Welcome</h2><p>Read more about <b>our team</b> on the <a href=\"entry:team\">team page</a>.</p>"
+    )
+  }
+}
@@ -0,0 +1,28 @@
+resource "contentful_entry" "article" {
+  entry_id       = "welcome"
+  space_id       = contentful_space.example.id
+  environment    = "master"
+  contenttype_id = "article"
+  published      = true
+
+  field {
+    id      = "title"
+    locale  = "en-US"
+    content = "Welcome"
+  }
+
+  field {
+    id     = "body"
+    locale = "en-US"
+    content = provider::contentful::richtext_from_markdown(<<-EOT
+      ## Welcome
+
+      Read more about **our team** on the [team page](entry:team).
+
+      {{entry:${contentful_entry.teaser.id}}}
+
+      {{asset:${contentful_asset.logo.id}}}
+    EOT
+    )
+  }
+}
@@ -0,0 +1,27 @@
+resource "contentful_contenttype" "product_teaser" {
+  space_id      = contentful_space.example.id
+  environment   = "master"
+  name          = "Product teaser"
+  display_field = "title"
+
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      type     = "Symbol"
+      required = true
+    }
+    product = {
+      position = 1
+      name     = "Product"
+      type     = "ResourceLink"
+      allowed_resources = [
+        {
+          type          = "Contentful:Entry"
+          source        = provider::contentful::space_crn(contentful_space.global.id)
+          content_types = ["product"]
+        }
+      ]
+    }
+  }
+}
@@ -4,18 +4,19 @@ resource "contentful_asset" "example_asset" {
   space_id    = "space-id"
 
   fields {
-    title {
-      locale  = "en-US"
-      content = "asset title"
+    title = {
+      "en-US" = "asset title"
+      "nl-NL" = "asset titel"
     }
-    description {
-      locale  = "en-US"
-      content = "asset description"
+    description = {
+      "en-US" = "asset description"
     }
-    file {
-      upload      = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      fileName    = "example.jpeg"
-      contentType = "image/jpeg"
+    file = {
+      "en-US" = {
+        upload       = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name    = "example.jpeg"
+        content_type = "image/jpeg"
+      }
     }
   }
   published = false
@@ -6,12 +6,14 @@ resource "contentful_contenttype" "some_other_content_type" {
   description   = "some other content type description"
   display_field = "content"
 
-  fields = [{
-    id       = "content"
-    name     = "Content"
-    type     = "RichText"
-    required = true
-  }]
+  field = {
+    content = {
+      position = 0
+      name     = "Content"
+      type     = "RichText"
+      required = true
+    }
+  }
 }
 
 resource "contentful_contenttype" "example_contenttype" {
@@ -22,19 +24,19 @@ resource "contentful_contenttype" "example_contenttype" {
   description   = "content type description"
   display_field = "asset_field"
 
-  fields = [
-    {
-      id   = "asset_field"
-      name = "Asset Field"
-      type = "Array"
+  field = {
+    asset_field = {
+      position = 0
+      name     = "Asset Field"
+      type     = "Array"
       items = {
         type      = "Link"
         link_type = "Asset"
       }
       required = true
-    },
-    {
-      id        = "entry_link_field"
+    }
+    entry_link_field = {
+      position  = 1
       name      = "Entry Link Field"
       type      = "Link"
       link_type = "Entry"
@@ -44,9 +46,9 @@ resource "contentful_contenttype" "example_contenttype" {
         }
       ]
       required = false
-    },
-    {
-      id       = "select",
+    }
+    select = {
+      position = 2
       name     = "Select Field",
       type     = "Symbol",
       required = true,
@@ -60,11 +62,11 @@ resource "contentful_contenttype" "example_contenttype" {
           ]
         }
       ]
-    },
-    {
-      id   = "themeColor"
-      name = "Theme Color"
-      type = "Symbol"
+    }
+    themeColor = {
+      position = 3
+      name     = "Theme Color"
+      type     = "Symbol"
       validations = [{
         in = ["green", "pink", "turquoise", "yellow", "purple"]
       }]
@@ -74,11 +76,11 @@ resource "contentful_contenttype" "example_contenttype" {
         }
       }
       required = false
-    },
-    {
-      id   = "content"
-      name = "Content"
-      type = "RichText"
+    }
+    content = {
+      position = 4
+      name     = "Content"
+      type     = "RichText"
       validations = [
         {
           size = {
@@ -108,5 +110,5 @@ resource "contentful_contenttype" "example_contenttype" {
       ]
       required = false
     }
-  ]
+  }
 }
@@ -6,29 +6,29 @@ resource "contentful_contenttype" "example_contenttype" {
   description   = "content type description"
   display_field = "name"
 
-  fields = [
-    {
-      id       = "name"
+  field = {
+    name = {
+      position = 0
       name     = "Name"
       type     = "Text"
       required = true
-    },
-    {
-      id       = "content"
+    }
+    content = {
+      position = 1
       name     = "Content"
       type     = "RichText"
       required = false
-    },
-    {
-      id   = "tags"
-      name = "Tags"
-      type = "Array"
+    }
+    tags = {
+      position = 2
+      name     = "Tags"
+      type     = "Array"
       items = {
         type = "Symbol"
       }
       required = false
     }
-  ]
+  }
 }
 
 resource "contentful_editor_interface" "example_editor_interface" {
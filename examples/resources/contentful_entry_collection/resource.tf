@@ -0,0 +1,18 @@
+# countries.yaml:
+#   netherlands:
+#     name:
+#       en-US: Netherlands
+#   germany:
+#     name:
+#       en-US: Germany
+resource "contentful_entry_collection" "countries" {
+  space_id       = "space-id"
+  environment    = "master"
+  contenttype_id = "country"
+
+  entries = {
+    for id, fields in yamldecode(file("${path.module}/countries.yaml")) : id => jsonencode(fields)
+  }
+
+  published = true
+}
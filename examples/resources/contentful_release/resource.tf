@@ -0,0 +1,13 @@
+resource "contentful_release" "campaign" {
+  space_id    = "space-id"
+  environment = "master"
+  title       = "Summer campaign"
+
+  entities = {
+    entries = [contentful_entry.landing_page.entry_id]
+    assets  = [contentful_asset.banner.id]
+  }
+
+  # Change the value to publish the release again
+  publish = "2026-06-21"
+}
@@ -43,15 +43,17 @@ resource "contentful_role" "example_role" {
       values = ["create"]
     }
 
-    constraint = jsonencode({
-      and = [
-        [
-          "equals",
-          { doc = "sys.type" },
-          "Entry"
-        ]
-      ]
-    })
+    condition {
+      and {
+        doc    = "sys.type"
+        equals = "Entry"
+      }
+
+      and {
+        doc = "sys.contentType.sys.id"
+        in  = ["blogPost", "author"]
+      }
+    }
   }
 }
 
@@ -0,0 +1,22 @@
+resource "contentful_tag" "governance" {
+  space_id    = "space-id"
+  environment = "master"
+  tag_id      = "governance"
+  name        = "Governance"
+  visibility  = "private"
+}
+
+resource "contentful_entry" "example_entry" {
+  entry_id       = "mytestentry"
+  space_id       = "space-id"
+  contenttype_id = "type-id"
+  environment    = "master"
+  field {
+    id      = "title"
+    content = "Hello, World!"
+    locale  = "en-US"
+  }
+  tags      = [contentful_tag.governance.id]
+  published = true
+  archived  = false
+}
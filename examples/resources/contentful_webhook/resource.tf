@@ -13,11 +13,32 @@ resource "contentful_webhook" "example_webhook" {
     header1 = "header1-value"
     header2 = "header2-value"
   }
-  http_basic_auth_username = "username"
-  http_basic_auth_password = "password"
+  secret_headers = {
+    Authorization = "Bearer secret"
+  }
+  secret_headers_version = 1
+
+  http_basic_auth_username         = "username"
+  http_basic_auth_password         = "password"
+  http_basic_auth_password_version = 1
+
+  filter {
+    doc = "sys.environment.sys.id"
+    in  = ["testing", "staging"]
+  }
 
-  filters = jsonencode([
-    { in : [{ "doc" : "sys.environment.sys.id" }, ["testing", "staging"]] },
-    { not : { equals : [{ "doc" : "sys.environment.sys.id" }, "master"] } },
-  ])
+  filter {
+    doc    = "sys.environment.sys.id"
+    equals = "master"
+    not    = true
+  }
+
+  transformation {
+    method       = "PUT"
+    content_type = "application/json"
+    body = jsonencode({
+      id    = "{ /payload/sys/id }"
+      topic = "{ /topic }"
+    })
+  }
 }
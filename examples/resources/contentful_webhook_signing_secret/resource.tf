@@ -0,0 +1,13 @@
+variable "webhook_signing_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "contentful_webhook_signing_secret" "example" {
+  space_id = "space-id"
+
+  # The secret is write-only, change the trigger to rotate it
+  value            = var.webhook_signing_secret
+  rotation_trigger = "2026-10"
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 // From https://developer.hashicorp.com/terraform/plugin/framework/resources/plan-modification#creating-attribute-plan-modifiers
@@ -22,30 +21,21 @@ func (s fieldTypeChangeProhibited) MarkdownDescription(ctx context.Context) stri
 	return s.Description(ctx)
 }
 
-func (s fieldTypeChangeProhibited) PlanModifyObject(ctx context.Context, request planmodifier.ObjectRequest, response *planmodifier.ObjectResponse) {
-	if request.StateValue.IsNull() {
+func (s fieldTypeChangeProhibited) PlanModifyObject(_ context.Context, request planmodifier.ObjectRequest, response *planmodifier.ObjectResponse) {
+	// The fields are keyed by their id, so the state value is the previous
+	// version of the same field. A new field has no state value.
+	if request.StateValue.IsNull() || request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
 		return
 	}
 
-	var fieldsList basetypes.ListValue
+	stateFields := request.StateValue.Attributes()
+	planFields := request.PlanValue.Attributes()
 
-	diags := request.State.GetAttribute(ctx, request.Path.ParentPath(), &fieldsList)
-
-	response.Diagnostics.Append(diags...)
-
-	for _, value := range fieldsList.Elements() {
-
-		stateFields := value.(basetypes.ObjectValue).Attributes()
-		planFields := request.PlanValue.Attributes()
-
-		if planFields["id"].Equal(stateFields["id"]) {
-			if !planFields["type"].Equal(stateFields["type"]) {
-				response.Diagnostics.AddError(
-					fmt.Sprintf("Content Type Field Type Change for Field %s", planFields["id"].String()), "Changing a field type in contentful is not possible. Pls follow this faq: "+
-						"https://www.contentful.com/faq/best-practices/#how-to-change-field-type",
-				)
-			}
-		}
+	if !planFields["type"].Equal(stateFields["type"]) {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Content Type Field Type Change for Field %s", request.Path.String()), "Changing a field type in contentful is not possible. Pls follow this faq: "+
+				"https://www.contentful.com/faq/best-practices/#how-to-change-field-type",
+		)
 	}
 }
 
@@ -0,0 +1,65 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &resourceLinkTypeValidator{}
+
+var resourceLinkTypeRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*:[A-Za-z][A-Za-z0-9]*$`)
+
+const contentfulResourceLinkPrefix = "Contentful:"
+
+// resourceLinkTypeValidator checks that the type of an allowed resource is
+// either one of the supported Contentful types or an external type provided
+// by an app, e.g. `Shopify:Product`
+type resourceLinkTypeValidator struct {
+	contentfulTypes []string
+}
+
+func (s resourceLinkTypeValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be one of %s or an external resource type in the format <Provider>:<Type>", strings.Join(s.contentfulTypes, ", "))
+}
+
+func (s resourceLinkTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func (s resourceLinkTypeValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if strings.HasPrefix(value, contentfulResourceLinkPrefix) {
+		if !slices.Contains(s.contentfulTypes, value) {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Resource Link Type",
+				fmt.Sprintf("The resource type %q is not supported, supported Contentful types are %s.", value, strings.Join(s.contentfulTypes, ", ")),
+			)
+		}
+		return
+	}
+
+	if !resourceLinkTypeRegex.MatchString(value) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Resource Link Type",
+			fmt.Sprintf("The resource type %q is not valid, external resource types need to be in the format <Provider>:<Type>, e.g. Shopify:Product.", value),
+		)
+	}
+}
+
+func ResourceLinkTypeValidator(contentfulTypes []string) validator.String {
+	return resourceLinkTypeValidator{
+		contentfulTypes: contentfulTypes,
+	}
+}
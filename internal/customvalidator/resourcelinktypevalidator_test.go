@@ -0,0 +1,37 @@
+package customvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceLinkTypeValidator(t *testing.T) {
+	cases := map[string]bool{
+		"Contentful:Entry":  true,
+		"Contentful:Asset":  true,
+		"Shopify:Product":   true,
+		"Contentful:Locale": false,
+		"Shopify":           false,
+		"Shopify:":          false,
+		"Shopify:Product:1": false,
+	}
+
+	for value, valid := range cases {
+		t.Run(value, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("type"),
+				ConfigValue: types.StringValue(value),
+			}
+			response := &validator.StringResponse{}
+
+			ResourceLinkTypeValidator([]string{"Contentful:Entry", "Contentful:Asset"}).ValidateString(context.Background(), request, response)
+
+			assert.Equal(t, !valid, response.Diagnostics.HasError())
+		})
+	}
+}
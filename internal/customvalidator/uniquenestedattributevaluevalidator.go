@@ -0,0 +1,74 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ validator.Map = uniqueNestedAttributeValueValidator{}
+
+// uniqueNestedAttributeValueValidator checks that an attribute of the nested
+// objects in a map has a different value for every element of the map
+type uniqueNestedAttributeValueValidator struct {
+	attributeName string
+}
+
+func (v uniqueNestedAttributeValueValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("The value of %s must be unique for every element", v.attributeName)
+}
+
+func (v uniqueNestedAttributeValueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniqueNestedAttributeValueValidator) ValidateMap(_ context.Context, request validator.MapRequest, response *validator.MapResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	elements := request.ConfigValue.Elements()
+
+	// Iterate in a stable order so the reported duplicate does not change
+	// between runs
+	keys := make([]string, 0, len(elements))
+	for key := range elements {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	seen := map[string]string{}
+	for _, key := range keys {
+		object, ok := elements[key].(basetypes.ObjectValue)
+		if !ok {
+			continue
+		}
+
+		value, ok := object.Attributes()[v.attributeName]
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		if other, exists := seen[value.String()]; exists {
+			response.Diagnostics.AddAttributeError(
+				request.Path.AtMapKey(key).AtName(v.attributeName),
+				"Duplicate Attribute Value",
+				fmt.Sprintf("The %s %s of %q is already used by %q, it must be unique.", v.attributeName, value.String(), key, other),
+			)
+			continue
+		}
+
+		seen[value.String()] = key
+	}
+}
+
+// UniqueNestedAttributeValue returns a validator which ensures that the given
+// attribute has a unique value across all the nested objects of a map
+func UniqueNestedAttributeValue(attributeName string) validator.Map {
+	return uniqueNestedAttributeValueValidator{
+		attributeName: attributeName,
+	}
+}
@@ -0,0 +1,114 @@
+package customvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &webhookBodyTemplateValidator{}
+
+// webhookTemplateRegex matches the placeholders in the string values of a
+// webhook body, e.g. { /payload/sys/id }
+var webhookTemplateRegex = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// webhookTemplateMissingSlashRegex matches placeholders which look like a JSON
+// pointer without the leading slash, e.g. { payload/sys/id }
+var webhookTemplateMissingSlashRegex = regexp.MustCompile(`^(payload|topic|user)\b`)
+
+var webhookTemplateRoots = []string{"payload", "topic", "user"}
+
+// webhookBodyTemplateValidator checks that the placeholders in the body of a
+// webhook transformation are valid JSON pointers into the webhook data
+type webhookBodyTemplateValidator struct{}
+
+func (s webhookBodyTemplateValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Placeholders in the body must be JSON pointers starting with one of /%s, e.g. { /payload/sys/id }", strings.Join(webhookTemplateRoots, ", /"))
+}
+
+func (s webhookBodyTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func (s webhookBodyTemplateValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var body any
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &body); err != nil {
+		// Invalid JSON is reported by the type of the attribute
+		return
+	}
+
+	for _, template := range webhookTemplates(body) {
+		if err := validateWebhookTemplate(template); err != nil {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Webhook Body Template",
+				fmt.Sprintf("The placeholder %q is not valid, %s. Placeholders are JSON pointers into the webhook data, e.g. { /payload/sys/id }.", "{"+template+"}", err.Error()),
+			)
+		}
+	}
+}
+
+// webhookTemplates returns the content of the placeholders in the string
+// values of the body
+func webhookTemplates(value any) []string {
+	var result []string
+	switch v := value.(type) {
+	case string:
+		for _, match := range webhookTemplateRegex.FindAllStringSubmatch(v, -1) {
+			result = append(result, match[1])
+		}
+	case []any:
+		for _, item := range v {
+			result = append(result, webhookTemplates(item)...)
+		}
+	case map[string]any:
+		for _, item := range v {
+			result = append(result, webhookTemplates(item)...)
+		}
+	}
+	return result
+}
+
+func validateWebhookTemplate(template string) error {
+	pointer := strings.TrimSpace(template)
+	if !strings.HasPrefix(pointer, "/") {
+		if webhookTemplateMissingSlashRegex.MatchString(pointer) {
+			return fmt.Errorf("it must start with a slash")
+		}
+
+		// Not a placeholder, e.g. literal braces in a text
+		return nil
+	}
+
+	segments := strings.Split(pointer[1:], "/")
+	if !slices.Contains(webhookTemplateRoots, segments[0]) {
+		return fmt.Errorf("it must start with one of /%s", strings.Join(webhookTemplateRoots, ", /"))
+	}
+
+	for _, segment := range segments[1:] {
+		if segment == "" {
+			return fmt.Errorf("it contains an empty segment")
+		}
+		if strings.ContainsAny(segment, " \t\n") {
+			return fmt.Errorf("the segment %q contains whitespace", segment)
+		}
+		if strings.Contains(strings.NewReplacer("~0", "", "~1", "").Replace(segment), "~") {
+			return fmt.Errorf("the segment %q contains a ~ which is not escaped as ~0 or ~1", segment)
+		}
+	}
+
+	return nil
+}
+
+func WebhookBodyTemplateValidator() validator.String {
+	return webhookBodyTemplateValidator{}
+}
@@ -0,0 +1,40 @@
+package customvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookBodyTemplateValidator(t *testing.T) {
+	cases := map[string]bool{
+		`{"id": "{ /payload/sys/id }"}`:                             true,
+		`{"topic": "{/topic}", "user": "{ /user/sys/id }"}`:         true,
+		`{"items": [{"title": "{ /payload/fields/title/en-US }"}]}`: true,
+		`{"escaped": "{ /payload/fields/a~1b }"}`:                   true,
+		`{"text": "Literal {braces} are kept"}`:                     true,
+		`{"id": "{ payload/sys/id }"}`:                              false,
+		`{"id": "{ /request/sys/id }"}`:                             false,
+		`{"id": "{ /payload//id }"}`:                                false,
+		`{"id": "{ /payload/sys id }"}`:                             false,
+		`{"id": "{ /payload/a~b }"}`:                                false,
+	}
+
+	for value, valid := range cases {
+		t.Run(value, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("transformation").AtName("body"),
+				ConfigValue: types.StringValue(value),
+			}
+			response := &validator.StringResponse{}
+
+			WebhookBodyTemplateValidator().ValidateString(context.Background(), request, response)
+
+			assert.Equal(t, !valid, response.Diagnostics.HasError())
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = &webhookTopicValidator{}
+
+// webhookTopicActions are the actions which can trigger a webhook per entity
+// type, see https://www.contentful.com/developers/docs/references/content-management-api/#/reference/webhooks
+var webhookTopicActions = map[string][]string{
+	"ContentType":     {"create", "save", "publish", "unpublish", "delete"},
+	"Entry":           {"create", "save", "auto_save", "archive", "unarchive", "publish", "unpublish", "delete"},
+	"Asset":           {"create", "save", "auto_save", "archive", "unarchive", "publish", "unpublish", "delete"},
+	"Task":            {"create", "save", "delete"},
+	"Comment":         {"create", "delete"},
+	"Release":         {"create", "save", "archive", "unarchive", "delete"},
+	"ReleaseAction":   {"create", "execute"},
+	"BulkAction":      {"create", "execute"},
+	"ScheduledAction": {"create", "save", "execute", "delete"},
+	"Concept":         {"create", "save", "publish", "unpublish", "delete"},
+	"ConceptScheme":   {"create", "save", "publish", "unpublish", "delete"},
+}
+
+// webhookTopicValidator checks that a webhook topic is in the format
+// <Entity>.<action>, where both parts can be a wildcard
+type webhookTopicValidator struct{}
+
+func (s webhookTopicValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Value must be in the format <Entity>.<action>, where the entity is * or one of %s and the action is * or an action of the entity", strings.Join(webhookTopicEntities(), ", "))
+}
+
+func (s webhookTopicValidator) MarkdownDescription(ctx context.Context) string {
+	return s.Description(ctx)
+}
+
+func (s webhookTopicValidator) ValidateString(_ context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateWebhookTopic(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Webhook Topic",
+			fmt.Sprintf("The topic %q is not valid, %s.", request.ConfigValue.ValueString(), err.Error()),
+		)
+	}
+}
+
+func validateWebhookTopic(topic string) error {
+	entity, action, found := strings.Cut(topic, ".")
+	if !found || entity == "" || action == "" || strings.Contains(action, ".") {
+		return fmt.Errorf("topics need to be in the format <Entity>.<action>, e.g. Entry.publish")
+	}
+
+	if entity != "*" {
+		actions, ok := webhookTopicActions[entity]
+		if !ok {
+			return fmt.Errorf("the entity must be * or one of %s", strings.Join(webhookTopicEntities(), ", "))
+		}
+
+		if action != "*" && !slices.Contains(actions, action) {
+			return fmt.Errorf("the action of %s must be * or one of %s", entity, strings.Join(actions, ", "))
+		}
+		return nil
+	}
+
+	if action == "*" {
+		return nil
+	}
+
+	for _, actions := range webhookTopicActions {
+		if slices.Contains(actions, action) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is not an action of any entity", action)
+}
+
+func webhookTopicEntities() []string {
+	entities := make([]string, 0, len(webhookTopicActions))
+	for entity := range webhookTopicActions {
+		entities = append(entities, entity)
+	}
+	sort.Strings(entities)
+	return entities
+}
+
+func WebhookTopicValidator() validator.String {
+	return webhookTopicValidator{}
+}
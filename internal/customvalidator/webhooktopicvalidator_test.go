@@ -0,0 +1,45 @@
+package customvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookTopicValidator(t *testing.T) {
+	cases := map[string]bool{
+		"Entry.publish":           true,
+		"Entry.auto_save":         true,
+		"Asset.*":                 true,
+		"*.publish":               true,
+		"*.*":                     true,
+		"ContentType.create":      true,
+		"ScheduledAction.execute": true,
+		"Entry.publsh":            false,
+		"ContentType.archive":     false,
+		"Entries.publish":         false,
+		"*.publsh":                false,
+		"Entry":                   false,
+		"Entry.":                  false,
+		".publish":                false,
+		"Entry.publish.now":       false,
+	}
+
+	for value, valid := range cases {
+		t.Run(value, func(t *testing.T) {
+			request := validator.StringRequest{
+				Path:        path.Root("topics").AtListIndex(0),
+				ConfigValue: types.StringValue(value),
+			}
+			response := &validator.StringResponse{}
+
+			WebhookTopicValidator().ValidateString(context.Background(), request, response)
+
+			assert.Equal(t, !valid, response.Diagnostics.HasError())
+		})
+	}
+}
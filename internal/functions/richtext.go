@@ -0,0 +1,584 @@
+package functions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// richTextNode is a node of a Contentful rich text document
+type richTextNode = map[string]any
+
+// shortcodePattern matches the shortcodes to embed entries and assets, e.g.
+// {{entry:3x4mpl3}} or {{asset:3x4mpl3}}
+var shortcodePattern = regexp.MustCompile(`\{\{\s*(entry|asset):([A-Za-z0-9._-]+)\s*\}\}`)
+
+// richTextShortcodesDescription documents the references to entries and
+// assets for both rich text functions
+const richTextShortcodesDescription = "Entries and assets are referenced with the following shortcodes:\n\n" +
+	"- `{{entry:<id>}}` embeds an entry. In a paragraph of its own the entry is embedded as a block, otherwise inline.\n" +
+	"- `{{asset:<id>}}` embeds an asset as a block, it must be in a paragraph of its own.\n" +
+	"- A link to `entry:<id>` or `asset:<id>` links to the entry or asset, e.g. `[our team](entry:team)`.\n\n" +
+	"Shortcodes in code are kept as text.\n"
+
+var whitespacePattern = regexp.MustCompile(`\s+`)
+
+// markdown converts markdown to html. Raw html is kept, so elements without a
+// markdown syntax, like <u>, can still be used.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(extension.Strikethrough, extension.Table),
+	goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+)
+
+var markTypes = map[atom.Atom]string{
+	atom.B:      "bold",
+	atom.Strong: "bold",
+	atom.I:      "italic",
+	atom.Em:     "italic",
+	atom.U:      "underline",
+	atom.Code:   "code",
+	atom.Sup:    "superscript",
+	atom.Sub:    "subscript",
+	atom.S:      "strikethrough",
+	atom.Del:    "strikethrough",
+	atom.Strike: "strikethrough",
+}
+
+var headingTypes = map[atom.Atom]string{
+	atom.H1: "heading-1",
+	atom.H2: "heading-2",
+	atom.H3: "heading-3",
+	atom.H4: "heading-4",
+	atom.H5: "heading-5",
+	atom.H6: "heading-6",
+}
+
+// RichTextFromMarkdown converts markdown to the JSON of a rich text document
+func RichTextFromMarkdown(input string) (string, error) {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(input), &buf); err != nil {
+		return "", err
+	}
+
+	return RichTextFromHTML(buf.String())
+}
+
+// RichTextFromHTML converts html to the JSON of a rich text document. The
+// document is marshalled the same way as the content of an entry field is
+// read, so the result can be used as the content of a field without changes
+// being planned after reading the entry.
+func RichTextFromHTML(input string) (string, error) {
+	body := &html.Node{Type: html.ElementNode, DataAtom: atom.Body, Data: "body"}
+	nodes, err := html.ParseFragment(strings.NewReader(input), body)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := convertBlocks(nodes)
+	if err != nil {
+		return "", err
+	}
+
+	document := newBlock("document", content)
+	data, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+func newBlock(nodeType string, content []any) richTextNode {
+	if content == nil {
+		content = []any{}
+	}
+
+	return richTextNode{
+		"nodeType": nodeType,
+		"data":     map[string]any{},
+		"content":  content,
+	}
+}
+
+func newText(value string, marks []string) richTextNode {
+	markNodes := make([]any, 0, len(marks))
+	for _, mark := range marks {
+		markNodes = append(markNodes, map[string]any{"type": mark})
+	}
+
+	return richTextNode{
+		"nodeType": "text",
+		"value":    value,
+		"marks":    markNodes,
+		"data":     map[string]any{},
+	}
+}
+
+func newLink(linkType string, id string) map[string]any {
+	return map[string]any{
+		"sys": map[string]any{
+			"id":       id,
+			"type":     "Link",
+			"linkType": linkType,
+		},
+	}
+}
+
+func newEmbed(nodeType string, linkType string, id string) richTextNode {
+	node := newBlock(nodeType, nil)
+	node["data"] = map[string]any{"target": newLink(linkType, id)}
+	return node
+}
+
+func isText(node any) bool {
+	n, ok := node.(richTextNode)
+	return ok && n["nodeType"] == "text"
+}
+
+// convertBlocks converts html nodes to block nodes. Inline content which is not
+// part of a block element is wrapped in a paragraph.
+func convertBlocks(nodes []*html.Node) ([]any, error) {
+	content := []any{}
+	var inline []any
+
+	flush := func() error {
+		if len(inline) == 0 {
+			return nil
+		}
+
+		block, err := newParagraph(inline)
+		if err != nil {
+			return err
+		}
+		if block != nil {
+			content = append(content, block)
+		}
+		inline = nil
+		return nil
+	}
+
+	for _, n := range nodes {
+		switch {
+		case n.Type == html.TextNode:
+			if strings.TrimSpace(n.Data) == "" && len(inline) == 0 {
+				continue
+			}
+
+			nodes, err := convertInline(n, nil)
+			if err != nil {
+				return nil, err
+			}
+			inline = append(inline, nodes...)
+
+		case n.Type != html.ElementNode:
+			continue
+
+		case isBlockElement(n):
+			if err := flush(); err != nil {
+				return nil, err
+			}
+
+			blocks, err := convertBlock(n)
+			if err != nil {
+				return nil, err
+			}
+			content = append(content, blocks...)
+
+		default:
+			nodes, err := convertInline(n, nil)
+			if err != nil {
+				return nil, err
+			}
+			inline = append(inline, nodes...)
+		}
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func isBlockElement(n *html.Node) bool {
+	if _, ok := headingTypes[n.DataAtom]; ok {
+		return true
+	}
+
+	switch n.DataAtom {
+	case atom.P, atom.Ul, atom.Ol, atom.Li, atom.Blockquote, atom.Hr, atom.Pre, atom.Table,
+		atom.Div, atom.Section, atom.Article, atom.Header, atom.Footer, atom.Main, atom.Aside:
+		return true
+	default:
+		return false
+	}
+}
+
+func children(n *html.Node) []*html.Node {
+	var result []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		result = append(result, c)
+	}
+	return result
+}
+
+func convertBlock(n *html.Node) ([]any, error) {
+	if nodeType, ok := headingTypes[n.DataAtom]; ok {
+		inline, err := convertInlineChildren(n, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range inline {
+			if node.(richTextNode)["nodeType"] == "embedded-asset-block" {
+				return nil, fmt.Errorf("the asset %q can not be embedded in a heading", entityID(node.(richTextNode)))
+			}
+		}
+		return []any{newBlock(nodeType, trimInline(inline))}, nil
+	}
+
+	switch n.DataAtom {
+	case atom.P:
+		inline, err := convertInlineChildren(n, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		block, err := newParagraph(inline)
+		if err != nil || block == nil {
+			return nil, err
+		}
+		return []any{block}, nil
+
+	case atom.Ul, atom.Ol:
+		nodeType := "unordered-list"
+		if n.DataAtom == atom.Ol {
+			nodeType = "ordered-list"
+		}
+
+		items := []any{}
+		for _, c := range children(n) {
+			if c.Type != html.ElementNode || c.DataAtom != atom.Li {
+				continue
+			}
+
+			item, err := convertListItem(c)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return []any{newBlock(nodeType, items)}, nil
+
+	case atom.Li:
+		// A list item without a list is wrapped in an unordered list
+		item, err := convertListItem(n)
+		if err != nil {
+			return nil, err
+		}
+		return []any{newBlock("unordered-list", []any{item})}, nil
+
+	case atom.Blockquote:
+		content, err := convertBlocks(children(n))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, block := range content {
+			if block.(richTextNode)["nodeType"] != "paragraph" {
+				return nil, fmt.Errorf("a blockquote can only contain paragraphs, got %s", block.(richTextNode)["nodeType"])
+			}
+		}
+		return []any{newBlock("blockquote", content)}, nil
+
+	case atom.Hr:
+		return []any{newBlock("hr", nil)}, nil
+
+	case atom.Pre:
+		value := strings.TrimSuffix(textContent(n), "\n")
+		return []any{newBlock("paragraph", []any{newText(value, []string{"code"})})}, nil
+
+	case atom.Table:
+		rows, err := convertTableRows(n)
+		if err != nil {
+			return nil, err
+		}
+		return []any{newBlock("table", rows)}, nil
+
+	default:
+		return convertBlocks(children(n))
+	}
+}
+
+func convertListItem(n *html.Node) (richTextNode, error) {
+	content, err := convertBlocks(children(n))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) == 0 {
+		content = []any{newBlock("paragraph", []any{newText("", nil)})}
+	}
+
+	return newBlock("list-item", content), nil
+}
+
+func convertTableRows(n *html.Node) ([]any, error) {
+	rows := []any{}
+	for _, c := range children(n) {
+		if c.Type != html.ElementNode {
+			continue
+		}
+
+		switch c.DataAtom {
+		case atom.Thead, atom.Tbody, atom.Tfoot:
+			nested, err := convertTableRows(c)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, nested...)
+
+		case atom.Tr:
+			cells := []any{}
+			for _, cell := range children(c) {
+				if cell.Type != html.ElementNode || (cell.DataAtom != atom.Td && cell.DataAtom != atom.Th) {
+					continue
+				}
+
+				nodeType := "table-cell"
+				if cell.DataAtom == atom.Th {
+					nodeType = "table-header-cell"
+				}
+
+				content, err := convertBlocks(children(cell))
+				if err != nil {
+					return nil, err
+				}
+				if len(content) == 0 {
+					content = []any{newBlock("paragraph", []any{newText("", nil)})}
+				}
+				cells = append(cells, newBlock(nodeType, content))
+			}
+			rows = append(rows, newBlock("table-row", cells))
+		}
+	}
+
+	return rows, nil
+}
+
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var result strings.Builder
+	for _, c := range children(n) {
+		result.WriteString(textContent(c))
+	}
+	return result.String()
+}
+
+// newParagraph returns a paragraph with the inline nodes. A paragraph which
+// only contains an embedded entry or asset becomes an embedded block, and a
+// paragraph without content is dropped.
+func newParagraph(inline []any) (richTextNode, error) {
+	inline = trimInline(inline)
+
+	var embeds []richTextNode
+	onlyEmbeds := true
+	for _, node := range inline {
+		n := node.(richTextNode)
+		switch {
+		case n["nodeType"] == "embedded-entry-inline" || n["nodeType"] == "embedded-asset-block":
+			embeds = append(embeds, n)
+		case n["nodeType"] != "text" || n["value"] != "":
+			onlyEmbeds = false
+		}
+	}
+
+	if onlyEmbeds && len(embeds) == 1 {
+		embed := embeds[0]
+		if embed["nodeType"] == "embedded-entry-inline" {
+			return newEmbed("embedded-entry-block", "Entry", entityID(embed)), nil
+		}
+		return embed, nil
+	}
+
+	for _, embed := range embeds {
+		if embed["nodeType"] == "embedded-asset-block" {
+			return nil, fmt.Errorf("the asset %q can only be embedded in a paragraph of its own", entityID(embed))
+		}
+	}
+
+	if onlyEmbeds && len(embeds) == 0 {
+		return nil, nil
+	}
+
+	return newBlock("paragraph", inline), nil
+}
+
+func entityID(embed richTextNode) string {
+	return embed["data"].(map[string]any)["target"].(map[string]any)["sys"].(map[string]any)["id"].(string)
+}
+
+// trimInline merges adjacent text nodes with the same marks and trims the
+// whitespace at the start and end. Like the Contentful web app, the content
+// starts and ends with a text node.
+func trimInline(inline []any) []any {
+	var result []any
+	for _, node := range inline {
+		if len(result) > 0 && isText(node) && isText(result[len(result)-1]) {
+			last := result[len(result)-1].(richTextNode)
+			n := node.(richTextNode)
+			if slices.EqualFunc(last["marks"].([]any), n["marks"].([]any), sameMark) {
+				last["value"] = last["value"].(string) + n["value"].(string)
+				continue
+			}
+		}
+		result = append(result, node)
+	}
+
+	if len(result) > 0 && isText(result[0]) {
+		first := result[0].(richTextNode)
+		first["value"] = strings.TrimLeft(first["value"].(string), " ")
+	}
+	if len(result) > 0 && isText(result[len(result)-1]) {
+		last := result[len(result)-1].(richTextNode)
+		last["value"] = strings.TrimRight(last["value"].(string), " ")
+	}
+
+	if len(result) == 0 || !isText(result[0]) {
+		result = append([]any{newText("", nil)}, result...)
+	}
+	if !isText(result[len(result)-1]) {
+		result = append(result, newText("", nil))
+	}
+
+	return result
+}
+
+func sameMark(a any, b any) bool {
+	return a.(map[string]any)["type"] == b.(map[string]any)["type"]
+}
+
+func convertInlineChildren(n *html.Node, marks []string) ([]any, error) {
+	result := []any{}
+	for _, c := range children(n) {
+		nodes, err := convertInline(c, marks)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodes...)
+	}
+	return result, nil
+}
+
+func convertInline(n *html.Node, marks []string) ([]any, error) {
+	if n.Type == html.TextNode {
+		return convertText(whitespacePattern.ReplaceAllString(n.Data, " "), marks), nil
+	}
+
+	if n.Type != html.ElementNode {
+		return nil, nil
+	}
+
+	if mark, ok := markTypes[n.DataAtom]; ok {
+		if !slices.Contains(marks, mark) {
+			marks = append(slices.Clone(marks), mark)
+		}
+		return convertInlineChildren(n, marks)
+	}
+
+	switch n.DataAtom {
+	case atom.Br:
+		return []any{newText("\n", marks)}, nil
+
+	case atom.A:
+		return convertHyperlink(n, marks)
+
+	case atom.Img:
+		return nil, fmt.Errorf("images are not supported, upload the image as an asset and embed it with {{asset:<id>}}")
+
+	default:
+		return convertInlineChildren(n, marks)
+	}
+}
+
+// convertText converts text to text nodes, replacing the shortcodes with
+// embedded entries and assets. Shortcodes in code are kept as text.
+func convertText(value string, marks []string) []any {
+	if slices.Contains(marks, "code") {
+		return []any{newText(value, marks)}
+	}
+
+	result := []any{}
+
+	start := 0
+	for _, match := range shortcodePattern.FindAllStringSubmatchIndex(value, -1) {
+		if match[0] > start {
+			result = append(result, newText(value[start:match[0]], marks))
+		}
+
+		kind, id := value[match[2]:match[3]], value[match[4]:match[5]]
+		if kind == "entry" {
+			result = append(result, newEmbed("embedded-entry-inline", "Entry", id))
+		} else {
+			result = append(result, newEmbed("embedded-asset-block", "Asset", id))
+		}
+		start = match[1]
+	}
+
+	if start < len(value) {
+		result = append(result, newText(value[start:], marks))
+	}
+
+	return result
+}
+
+func convertHyperlink(n *html.Node, marks []string) ([]any, error) {
+	var href string
+	for _, attr := range n.Attr {
+		if attr.Key == "href" {
+			href = attr.Val
+		}
+	}
+
+	content, err := convertInlineChildren(n, marks)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, node := range content {
+		if !isText(node) {
+			return nil, fmt.Errorf("the link to %q can only contain text", href)
+		}
+	}
+
+	var link richTextNode
+	switch {
+	case strings.HasPrefix(href, "entry:"):
+		link = newBlock("entry-hyperlink", content)
+		link["data"] = map[string]any{"target": newLink("Entry", strings.TrimPrefix(href, "entry:"))}
+	case strings.HasPrefix(href, "asset:"):
+		link = newBlock("asset-hyperlink", content)
+		link["data"] = map[string]any{"target": newLink("Asset", strings.TrimPrefix(href, "asset:"))}
+	default:
+		link = newBlock("hyperlink", content)
+		link["data"] = map[string]any{"uri": href}
+	}
+
+	if len(content) == 0 {
+		link["content"] = []any{newText(href, marks)}
+	}
+
+	return []any{link}, nil
+}
@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &richTextFromHTMLFunction{}
+
+func NewRichTextFromHTMLFunction() function.Function {
+	return &richTextFromHTMLFunction{}
+}
+
+// richTextFromHTMLFunction converts html to a rich text document, to be used
+// as the content of a RichText field of an entry.
+type richTextFromHTMLFunction struct{}
+
+func (f *richTextFromHTMLFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "richtext_from_html"
+}
+
+func (f *richTextFromHTMLFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Converts html to a rich text document",
+		MarkdownDescription: "Returns the JSON of a rich text document for the given html, to be used as the `content` " +
+			"of a RichText field of a `contentful_entry`.\n\n" +
+			"The `p`, `h1` to `h6`, `ul`, `ol`, `li`, `blockquote`, `hr`, `pre`, `table` and `a` elements are supported, " +
+			"as are the `b`, `strong`, `i`, `em`, `u`, `code`, `sup`, `sub`, `s` and `del` marks. Other elements, like " +
+			"`div` and `span`, are replaced by their content. Images are not supported, embed an asset instead.\n\n" +
+			richTextShortcodesDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "html",
+				MarkdownDescription: "The html to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *richTextFromHTMLFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = request.Arguments.Get(ctx, &input)
+	if response.Error != nil {
+		return
+	}
+
+	document, err := RichTextFromHTML(input)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Could not convert html to rich text: "+err.Error())
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, document)
+}
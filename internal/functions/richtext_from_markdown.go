@@ -0,0 +1,57 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &richTextFromMarkdownFunction{}
+
+func NewRichTextFromMarkdownFunction() function.Function {
+	return &richTextFromMarkdownFunction{}
+}
+
+// richTextFromMarkdownFunction converts markdown to a rich text document, to
+// be used as the content of a RichText field of an entry.
+type richTextFromMarkdownFunction struct{}
+
+func (f *richTextFromMarkdownFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "richtext_from_markdown"
+}
+
+func (f *richTextFromMarkdownFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Converts markdown to a rich text document",
+		MarkdownDescription: "Returns the JSON of a rich text document for the given markdown, to be used as the `content` " +
+			"of a RichText field of a `contentful_entry`.\n\n" +
+			"Headings, paragraphs, ordered and unordered lists, blockquotes, horizontal rules, tables, code blocks and " +
+			"links are supported, as are the bold, italic, code and strikethrough (`~~text~~`) marks. Html can be " +
+			"used for the marks without a markdown syntax, e.g. `<u>`, `<sup>` and `<sub>`.\n\n" +
+			richTextShortcodesDescription,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "markdown",
+				MarkdownDescription: "The markdown to convert",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *richTextFromMarkdownFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input string
+
+	response.Error = request.Arguments.Get(ctx, &input)
+	if response.Error != nil {
+		return
+	}
+
+	document, err := RichTextFromMarkdown(input)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, "Could not convert markdown to rich text: "+err.Error())
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, document)
+}
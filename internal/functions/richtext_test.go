@@ -0,0 +1,189 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
+)
+
+func text(value string, marks ...string) string {
+	result := `{"nodeType": "text", "value": "` + value + `", "data": {}, "marks": [`
+	for i, mark := range marks {
+		if i > 0 {
+			result += ","
+		}
+		result += `{"type": "` + mark + `"}`
+	}
+	return result + `]}`
+}
+
+func TestRichTextFromMarkdown(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:  "heading and marks",
+			input: "## Title\n\nHello **bold** and _italic_ ~~gone~~ <u>under</u>",
+			expected: `[
+				{"nodeType": "heading-2", "data": {}, "content": [` + text("Title") + `]},
+				{"nodeType": "paragraph", "data": {}, "content": [` +
+				text("Hello ") + `,` + text("bold", "bold") + `,` + text(" and ") + `,` + text("italic", "italic") + `,` +
+				text(" ") + `,` + text("gone", "strikethrough") + `,` + text(" ") + `,` + text("under", "underline") + `]}
+			]`,
+		},
+		{
+			name:  "lists",
+			input: "- one\n  1. nested",
+			expected: `[
+				{"nodeType": "unordered-list", "data": {}, "content": [
+					{"nodeType": "list-item", "data": {}, "content": [
+						{"nodeType": "paragraph", "data": {}, "content": [` + text("one") + `]},
+						{"nodeType": "ordered-list", "data": {}, "content": [
+							{"nodeType": "list-item", "data": {}, "content": [
+								{"nodeType": "paragraph", "data": {}, "content": [` + text("nested") + `]}
+							]}
+						]}
+					]}
+				]}
+			]`,
+		},
+		{
+			name:  "links",
+			input: "[site](https://example.com) and [team](entry:team) or [logo](asset:logo)",
+			expected: `[
+				{"nodeType": "paragraph", "data": {}, "content": [` + text("") + `,
+					{"nodeType": "hyperlink", "data": {"uri": "https://example.com"}, "content": [` + text("site") + `]},` +
+				text(" and ") + `,
+					{"nodeType": "entry-hyperlink", "data": {"target": {"sys": {"id": "team", "type": "Link", "linkType": "Entry"}}}, "content": [` + text("team") + `]},` +
+				text(" or ") + `,
+					{"nodeType": "asset-hyperlink", "data": {"target": {"sys": {"id": "logo", "type": "Link", "linkType": "Asset"}}}, "content": [` + text("logo") + `]},` +
+				text("") + `
+				]}
+			]`,
+		},
+		{
+			name:  "embedded entries and assets",
+			input: "{{entry:teaser}}\n\nSee {{ entry:author }}\n\n{{asset:logo}}",
+			expected: `[
+				{"nodeType": "embedded-entry-block", "data": {"target": {"sys": {"id": "teaser", "type": "Link", "linkType": "Entry"}}}, "content": []},
+				{"nodeType": "paragraph", "data": {}, "content": [` + text("See ") + `,
+					{"nodeType": "embedded-entry-inline", "data": {"target": {"sys": {"id": "author", "type": "Link", "linkType": "Entry"}}}, "content": []},` +
+				text("") + `
+				]},
+				{"nodeType": "embedded-asset-block", "data": {"target": {"sys": {"id": "logo", "type": "Link", "linkType": "Asset"}}}, "content": []}
+			]`,
+		},
+		{
+			name:  "shortcodes in code",
+			input: "Use `{{entry:id}}` to embed an entry\n\n```\n{{asset:logo}}\n```",
+			expected: `[
+				{"nodeType": "paragraph", "data": {}, "content": [` + text("Use ") + `,` + text("{{entry:id}}", "code") + `,` + text(" to embed an entry") + `]},
+				{"nodeType": "paragraph", "data": {}, "content": [` + text("{{asset:logo}}", "code") + `]}
+			]`,
+		},
+		{
+			name:  "quote, rule and code",
+			input: "> quoted\n\n---\n\n```\nfmt.Println()\n```",
+			expected: `[
+				{"nodeType": "blockquote", "data": {}, "content": [
+					{"nodeType": "paragraph", "data": {}, "content": [` + text("quoted") + `]}
+				]},
+				{"nodeType": "hr", "data": {}, "content": []},
+				{"nodeType": "paragraph", "data": {}, "content": [` + text("fmt.Println()", "code") + `]}
+			]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := RichTextFromMarkdown(tc.input)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"nodeType": "document", "data": {}, "content": `+tc.expected+`}`, result)
+		})
+	}
+}
+
+func TestRichTextFromHTML(t *testing.T) {
+	result, err := RichTextFromHTML(`<h3>Title</h3>
+		<div>Loose <strong>text</strong><br>and <span>more</span></div>
+		<table><tr><th>Name</th></tr><tr><td>Value</td></tr></table>`)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"nodeType": "document", "data": {}, "content": [
+		{"nodeType": "heading-3", "data": {}, "content": [`+text("Title")+`]},
+		{"nodeType": "paragraph", "data": {}, "content": [`+text("Loose ")+`,`+text("text", "bold")+`,`+text(`\nand more`)+`]},
+		{"nodeType": "table", "data": {}, "content": [
+			{"nodeType": "table-row", "data": {}, "content": [
+				{"nodeType": "table-header-cell", "data": {}, "content": [
+					{"nodeType": "paragraph", "data": {}, "content": [`+text("Name")+`]}
+				]}
+			]},
+			{"nodeType": "table-row", "data": {}, "content": [
+				{"nodeType": "table-cell", "data": {}, "content": [
+					{"nodeType": "paragraph", "data": {}, "content": [`+text("Value")+`]}
+				]}
+			]}
+		]}
+	]}`, result)
+}
+
+func TestRichTextFromHTMLErrors(t *testing.T) {
+	for _, input := range []string{
+		`<p>An inline {{asset:logo}} asset</p>`,
+		`<img src="logo.png">`,
+		`<blockquote><h1>Title</h1></blockquote>`,
+		`<a href="entry:team">{{entry:team}}</a>`,
+		`<h1>{{asset:logo}}</h1>`,
+	} {
+		_, err := RichTextFromHTML(input)
+		assert.Error(t, err, input)
+	}
+}
+
+// The document must be written the same way as the content of an entry field
+// is read, otherwise changes are planned after every apply
+func TestRichTextIsStable(t *testing.T) {
+	result, err := RichTextFromMarkdown("# Q&A\n\nIs 1 < 2? **Yes** see [docs](https://example.com?a=1&b=2)\n\n{{entry:faq}}")
+	assert.NoError(t, err)
+
+	content, err := json.Marshal(entry.ParseContentValue(result))
+	assert.NoError(t, err)
+	assert.Equal(t, result, string(content))
+}
+
+func TestRichTextFromMarkdownFunction(t *testing.T) {
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("Hello")}),
+	}
+	response := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewRichTextFromMarkdownFunction().Run(context.Background(), request, response)
+
+	assert.Nil(t, response.Error)
+	assert.Equal(t,
+		types.StringValue(`{"content":[{"content":[{"data":{},"marks":[],"nodeType":"text","value":"Hello"}],"data":{},"nodeType":"paragraph"}],"data":{},"nodeType":"document"}`),
+		response.Result.Value())
+}
+
+func TestRichTextFromHTMLFunctionReturnsErrorForImages(t *testing.T) {
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(`<img src="logo.png">`)}),
+	}
+	response := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewRichTextFromHTMLFunction().Run(context.Background(), request, response)
+
+	assert.NotNil(t, response.Error)
+}
@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+var _ function.Function = &spaceCRNFunction{}
+
+func NewSpaceCRNFunction() function.Function {
+	return &spaceCRNFunction{}
+}
+
+// spaceCRNFunction builds the CRN of a space, which is used as the source of
+// the allowed resources of a ResourceLink field.
+type spaceCRNFunction struct{}
+
+func (f *spaceCRNFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "space_crn"
+}
+
+func (f *spaceCRNFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary: "Builds the CRN of a space",
+		MarkdownDescription: "Returns the Contentful Resource Name (`crn:contentful:::content:spaces/<space id>`) of a " +
+			"space, to be used as `source` of the `allowed_resources` of a ResourceLink field.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "space_id",
+				MarkdownDescription: "The id of the space, e.g. `contentful_space.example.id`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *spaceCRNFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var spaceId string
+
+	response.Error = request.Arguments.Get(ctx, &spaceId)
+	if response.Error != nil {
+		return
+	}
+
+	if spaceId == "" {
+		response.Error = function.NewArgumentFuncError(0, "The space id must not be empty")
+		return
+	}
+
+	// The CRN is used as the source of allowed resources, which is validated
+	// with the same pattern
+	crn := utils.SpaceCRN(spaceId)
+	if !utils.SpaceCRNRegex.MatchString(crn) {
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("The space id %q is not valid, it may only contain letters, digits, underscores and dashes", spaceId))
+		return
+	}
+
+	response.Error = response.Result.Set(ctx, crn)
+}
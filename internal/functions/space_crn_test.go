@@ -0,0 +1,53 @@
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpaceCRNFunction(t *testing.T) {
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("abc123")}),
+	}
+	response := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewSpaceCRNFunction().Run(context.Background(), request, response)
+
+	assert.Nil(t, response.Error)
+	assert.Equal(t, types.StringValue("crn:contentful:::content:spaces/abc123"), response.Result.Value())
+}
+
+func TestSpaceCRNFunctionReturnsErrorForEmptySpaceId(t *testing.T) {
+	request := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("")}),
+	}
+	response := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewSpaceCRNFunction().Run(context.Background(), request, response)
+
+	assert.NotNil(t, response.Error)
+}
+
+func TestSpaceCRNFunctionReturnsErrorForInvalidSpaceId(t *testing.T) {
+	for _, spaceId := range []string{"abc/123", "abc 123", "abc:123"} {
+		request := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(spaceId)}),
+		}
+		response := &function.RunResponse{
+			Result: function.NewResultData(types.StringUnknown()),
+		}
+
+		NewSpaceCRNFunction().Run(context.Background(), request, response)
+
+		assert.NotNil(t, response.Error, spaceId)
+	}
+}
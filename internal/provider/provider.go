@@ -5,12 +5,17 @@ import (
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_event_subscription"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/labd/terraform-provider-contentful/internal/functions"
 	"github.com/labd/terraform-provider-contentful/internal/resources/api_key"
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_definition"
 	"github.com/labd/terraform-provider-contentful/internal/resources/app_installation"
@@ -18,17 +23,23 @@ import (
 	"github.com/labd/terraform-provider-contentful/internal/resources/contenttype"
 	"github.com/labd/terraform-provider-contentful/internal/resources/editor_interface"
 	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry_collection"
 	"github.com/labd/terraform-provider-contentful/internal/resources/environment"
 	"github.com/labd/terraform-provider-contentful/internal/resources/locale"
 	"github.com/labd/terraform-provider-contentful/internal/resources/preview_environment"
+	"github.com/labd/terraform-provider-contentful/internal/resources/release"
 	"github.com/labd/terraform-provider-contentful/internal/resources/role"
 	"github.com/labd/terraform-provider-contentful/internal/resources/space"
+	"github.com/labd/terraform-provider-contentful/internal/resources/tag"
 	"github.com/labd/terraform-provider-contentful/internal/resources/webhook"
+	"github.com/labd/terraform-provider-contentful/internal/resources/webhook_signing_secret"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 var (
-	_ provider.Provider = &contentfulProvider{}
+	_ provider.Provider                       = &contentfulProvider{}
+	_ provider.ProviderWithFunctions          = &contentfulProvider{}
+	_ provider.ProviderWithEphemeralResources = &contentfulProvider{}
 )
 
 func New(version string, debug bool) func() provider.Provider {
@@ -47,10 +58,14 @@ type contentfulProvider struct {
 
 // Provider schema struct
 type contentfulProviderModel struct {
-	CmaToken       types.String `tfsdk:"cma_token"`
-	OrganizationId types.String `tfsdk:"organization_id"`
-	BaseURL        types.String `tfsdk:"base_url"`
-	Environment    types.String `tfsdk:"environment"`
+	CmaToken         types.String `tfsdk:"cma_token"`
+	OrganizationId   types.String `tfsdk:"organization_id"`
+	BaseURL          types.String `tfsdk:"base_url"`
+	Environment      types.String `tfsdk:"environment"`
+	AdoptExisting    types.Bool   `tfsdk:"adopt_existing"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	DefaultTags      types.Set    `tfsdk:"default_tags"`
+	OmitApiKeyTokens types.Bool   `tfsdk:"omit_api_key_tokens"`
 }
 
 func (c contentfulProvider) Metadata(_ context.Context, _ provider.MetadataRequest, response *provider.MetadataResponse) {
@@ -78,6 +93,31 @@ func (c contentfulProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "The environment to use for the Contentful API. Defaults to master",
 			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				Description: "Adopt objects that already exist in Contentful instead of failing on create. Applies to content " +
+					"types, locales, roles, webhooks, entries and assets and can be overridden per resource. Defaults to false",
+			},
+			"on_destroy": schema.StringAttribute{
+				Optional: true,
+				Description: utils.OnDestroyDescription("entry or asset") + " Can be overridden per resource. " +
+					"Defaults to delete",
+				Validators: []validator.String{
+					stringvalidator.OneOf(utils.GetOnDestroyValues()...),
+				},
+			},
+			"default_tags": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "IDs of the tags which are added to all entries and assets, e.g. to mark the content which is " +
+					"managed by Terraform. The tags must exist in the environment of the entries and assets.",
+			},
+			"omit_api_key_tokens": schema.BoolAttribute{
+				Optional: true,
+				Description: "Do not store the `access_token` and `preview_token` of `contentful_apikey` resources in the " +
+					"state. Use the `contentful_api_key_token` and `contentful_preview_api_key_token` ephemeral resources " +
+					"to retrieve the tokens instead. Defaults to false",
+			},
 		},
 	}
 }
@@ -120,6 +160,11 @@ func (c contentfulProvider) Configure(ctx context.Context, request provider.Conf
 		baseURL = config.BaseURL.ValueString()
 	}
 
+	environment := config.Environment.ValueString()
+	if environment == "" {
+		environment = "master"
+	}
+
 	clientNew, err := utils.CreateClient(baseURL, cmaToken)
 	if err != nil {
 		panic(err)
@@ -131,18 +176,26 @@ func (c contentfulProvider) Configure(ctx context.Context, request provider.Conf
 	}
 
 	data := utils.ProviderData{
-		Client:         clientNew,
-		ClientUpload:   clientUpload,
-		OrganizationId: organizationId,
+		Client:           clientNew,
+		ClientUpload:     clientUpload,
+		OrganizationId:   organizationId,
+		Environment:      environment,
+		AdoptExisting:    config.AdoptExisting.ValueBool(),
+		OnDestroy:        config.OnDestroy.ValueString(),
+		DefaultTags:      utils.SetStrings(config.DefaultTags),
+		OmitApiKeyTokens: config.OmitApiKeyTokens.ValueBool(),
 	}
 
 	response.ResourceData = data
 	response.DataSourceData = data
+	response.EphemeralResourceData = data
 }
 
 func (c contentfulProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		space.NewSpaceDataSource,
+		webhook.NewWebhookCallsDataSource,
+		webhook.NewWebhookHealthDataSource,
 	}
 }
 
@@ -156,11 +209,30 @@ func (c contentfulProvider) Resources(_ context.Context) []func() resource.Resou
 		contenttype.NewContentTypeResource,
 		editor_interface.NewEditorInterfaceResource,
 		entry.NewEntryResource,
+		entry_collection.NewEntryCollectionResource,
 		environment.NewEnvironmentResource,
 		locale.NewLocaleResource,
 		preview_environment.NewPreviewEnvironmentResource,
+		release.NewReleaseResource,
 		role.NewRoleResource,
 		space.NewSpaceResource,
+		tag.NewTagResource,
 		webhook.NewWebhookResource,
+		webhook_signing_secret.NewWebhookSigningSecretResource,
+	}
+}
+
+func (c contentfulProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		api_key.NewApiKeyTokenEphemeralResource,
+		api_key.NewPreviewApiKeyTokenEphemeralResource,
+	}
+}
+
+func (c contentfulProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewRichTextFromHTMLFunction,
+		functions.NewRichTextFromMarkdownFunction,
+		functions.NewSpaceCRNFunction,
 	}
 }
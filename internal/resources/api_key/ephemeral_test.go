@@ -0,0 +1,85 @@
+package api_key_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	hashicor_acctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestApiKeyTokenEphemeralResource(t *testing.T) {
+	name := fmt.Sprintf("apikey-name-%s", hashicor_acctest.RandString(3))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulApiKeyDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+			"echo":       echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testApiKeyTokens(os.Getenv("CONTENTFUL_SPACE_ID"), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("contentful_apikey.myapikey", "access_token"),
+					resource.TestCheckNoResourceAttr("contentful_apikey.myapikey", "preview_token"),
+					resource.TestMatchResourceAttr("echo.access_token", "data.access_token", regexp.MustCompile(`^.+$`)),
+					resource.TestMatchResourceAttr("echo.preview_token", "data.access_token", regexp.MustCompile(`^.+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testApiKeyTokens(spaceId string, name string) string {
+	return fmt.Sprintf(`
+provider "contentful" {
+  omit_api_key_tokens = true
+}
+
+resource "contentful_apikey" "myapikey" {
+  space_id = "%[1]s"
+  name     = "%[2]s"
+}
+
+ephemeral "contentful_api_key_token" "access_token" {
+  space_id   = "%[1]s"
+  api_key_id = contentful_apikey.myapikey.id
+}
+
+ephemeral "contentful_preview_api_key_token" "preview_token" {
+  space_id           = "%[1]s"
+  preview_api_key_id = contentful_apikey.myapikey.preview_id
+}
+
+provider "echo" {
+  alias = "access_token"
+  data  = ephemeral.contentful_api_key_token.access_token
+}
+
+provider "echo" {
+  alias = "preview_token"
+  data  = ephemeral.contentful_preview_api_key_token.preview_token
+}
+
+resource "echo" "access_token" {
+  provider = echo.access_token
+}
+
+resource "echo" "preview_token" {
+  provider = echo.preview_token
+}
+`, spaceId, name)
+}
@@ -60,3 +60,18 @@ func (a *ApiKey) Draft() *sdk.ApiKeyDraft {
 
 	return draft
 }
+
+// ApiKeyToken is the ephemeral resource data of the token of an api key
+type ApiKeyToken struct {
+	SpaceId     types.String `tfsdk:"space_id"`
+	ApiKeyId    types.String `tfsdk:"api_key_id"`
+	AccessToken types.String `tfsdk:"access_token"`
+}
+
+// PreviewApiKeyToken is the ephemeral resource data of the token of a preview
+// api key
+type PreviewApiKeyToken struct {
+	SpaceId         types.String `tfsdk:"space_id"`
+	PreviewApiKeyId types.String `tfsdk:"preview_api_key_id"`
+	AccessToken     types.String `tfsdk:"access_token"`
+}
@@ -0,0 +1,83 @@
+package api_key
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &previewApiKeyTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &previewApiKeyTokenEphemeralResource{}
+)
+
+func NewPreviewApiKeyTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &previewApiKeyTokenEphemeralResource{}
+}
+
+// previewApiKeyTokenEphemeralResource is the ephemeral resource implementation.
+type previewApiKeyTokenEphemeralResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *previewApiKeyTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_preview_api_key_token"
+}
+
+func (e *previewApiKeyTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Retrieves the Content Preview API token of a preview api key without storing it in the state.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "space id",
+			},
+			"preview_api_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "preview api key id, the `preview_id` of the api key",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Content Preview API token",
+			},
+		},
+	}
+}
+
+func (e *previewApiKeyTokenEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *previewApiKeyTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data PreviewApiKeyToken
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetPreviewApiKeyWithResponse(ctx, data.SpaceId.ValueString(), data.PreviewApiKeyId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error reading preview api key",
+			"Could not retrieve preview api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.AccessToken = types.StringValue(resp.JSON200.AccessToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, data)...)
+}
@@ -33,7 +33,8 @@ func NewApiKeyResource() resource.Resource {
 
 // apiKeyResource is the resource implementation.
 type apiKeyResource struct {
-	client *sdk.ClientWithResponses
+	client     *sdk.ClientWithResponses
+	omitTokens bool
 }
 
 func (e *apiKeyResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -72,6 +73,8 @@ func (e *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"access_token": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
+				Description: "The Content Delivery API token, not set when `omit_api_key_tokens` is enabled on the " +
+					"provider",
 			},
 			"name": schema.StringAttribute{
 				Required: true,
@@ -82,6 +85,8 @@ func (e *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			"preview_token": schema.StringAttribute{
 				Computed:  true,
 				Sensitive: true,
+				Description: "The Content Preview API token, not set when `omit_api_key_tokens` is enabled on the " +
+					"provider",
 			},
 			"environments": schema.ListAttribute{
 				Optional:    true,
@@ -103,6 +108,7 @@ func (e *apiKeyResource) Configure(_ context.Context, request resource.Configure
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.omitTokens = data.OmitApiKeyTokens
 }
 
 func (e *apiKeyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -125,15 +131,13 @@ func (e *apiKeyResource) Create(ctx context.Context, request resource.CreateRequ
 	}
 	state.Import(resp.JSON201)
 
-	previewApiKeyContentful, err := e.getPreviewApiKey(ctx, state)
-	if err != nil {
+	if err := e.setPreviewToken(ctx, state); err != nil {
 		response.Diagnostics.AddError(
 			"Error reading preview api key",
 			"Could not retrieve preview api key, unexpected error: "+err.Error(),
 		)
 		return
 	}
-	state.PreviewToken = types.StringValue(previewApiKeyContentful.AccessToken)
 
 	// Set state to fully populated data
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
@@ -189,14 +193,11 @@ func (e *apiKeyResource) Update(ctx context.Context, request resource.UpdateRequ
 	apiKey := resp.JSON200
 	state.Import(apiKey)
 
-	previewApiKeyContentful, err := e.getPreviewApiKey(ctx, plan)
-	if err != nil {
+	if err := e.setPreviewToken(ctx, state); err != nil {
 		response.Diagnostics.AddError("Error reading preview api key", err.Error())
 		return
 	}
 
-	state.PreviewToken = types.StringValue(previewApiKeyContentful.AccessToken)
-
 	// Set state to fully populated data
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 	if response.Diagnostics.HasError() {
@@ -251,14 +252,11 @@ func (e *apiKeyResource) doRead(ctx context.Context, apiKey *ApiKey, state *tfsd
 
 	apiKey.Import(apiKeyContentful)
 
-	previewApiKeyContentful, err := e.getPreviewApiKey(ctx, apiKey)
-	if err != nil {
+	if err := e.setPreviewToken(ctx, apiKey); err != nil {
 		d.AddError("Error reading preview api key", err.Error())
 		return
 	}
 
-	apiKey.PreviewToken = types.StringValue(previewApiKeyContentful.AccessToken)
-
 	// Set refreshed state
 	d.Append(state.Set(ctx, &apiKey)...)
 	if d.HasError() {
@@ -266,6 +264,24 @@ func (e *apiKeyResource) doRead(ctx context.Context, apiKey *ApiKey, state *tfsd
 	}
 }
 
+// setPreviewToken sets the preview token of the api key, or removes both tokens
+// when they should not be stored in the state
+func (e *apiKeyResource) setPreviewToken(ctx context.Context, apiKey *ApiKey) error {
+	if e.omitTokens {
+		apiKey.AccessToken = types.StringNull()
+		apiKey.PreviewToken = types.StringNull()
+		return nil
+	}
+
+	previewApiKey, err := e.getPreviewApiKey(ctx, apiKey)
+	if err != nil {
+		return err
+	}
+
+	apiKey.PreviewToken = types.StringValue(previewApiKey.AccessToken)
+	return nil
+}
+
 func (e *apiKeyResource) getApiKey(ctx context.Context, apiKey *ApiKey) (*sdk.ApiKey, error) {
 	resp, err := e.client.GetApiKeyWithResponse(ctx, apiKey.SpaceId.ValueString(), apiKey.ID.ValueString())
 	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
@@ -0,0 +1,83 @@
+package api_key
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &apiKeyTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &apiKeyTokenEphemeralResource{}
+)
+
+func NewApiKeyTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &apiKeyTokenEphemeralResource{}
+}
+
+// apiKeyTokenEphemeralResource is the ephemeral resource implementation.
+type apiKeyTokenEphemeralResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *apiKeyTokenEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_api_key_token"
+}
+
+func (e *apiKeyTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Retrieves the Content Delivery API token of an api key without storing it in the state.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "space id",
+			},
+			"api_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "api key id",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Content Delivery API token",
+			},
+		},
+	}
+}
+
+func (e *apiKeyTokenEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, _ *ephemeral.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *apiKeyTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data ApiKeyToken
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetApiKeyWithResponse(ctx, data.SpaceId.ValueString(), data.ApiKeyId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error reading api key",
+			"Could not retrieve api key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	data.AccessToken = types.StringValue(resp.JSON200.AccessToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, data)...)
+}
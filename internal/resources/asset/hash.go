@@ -0,0 +1,62 @@
+package asset
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// contentHashTimeout is the maximum duration of downloading a file to hash
+	contentHashTimeout = 2 * time.Minute
+
+	// contentHashMaxSize is the maximum size of a file which is hashed
+	contentHashMaxSize = 100 << 20
+)
+
+var contentHashClient = &http.Client{Timeout: contentHashTimeout}
+
+// contentHash downloads a processed file and returns its SHA256 hash, hex
+// encoded like the hashes of filesha256(). The URLs of processed files are
+// protocol relative, e.g. //images.ctfassets.net/... Files larger than
+// maxSize are not hashed.
+func contentHash(ctx context.Context, client *http.Client, url string, maxSize int64) (string, error) {
+	if strings.HasPrefix(url, "//") {
+		url = "https:" + url
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d for %s", resp.StatusCode, url)
+	}
+
+	if resp.ContentLength > maxSize {
+		return "", fmt.Errorf("the file of %d bytes exceeds the maximum size of %d bytes", resp.ContentLength, maxSize)
+	}
+
+	hash := sha256.New()
+	n, err := io.Copy(hash, io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return "", err
+	}
+	if n > maxSize {
+		return "", fmt.Errorf("the file exceeds the maximum size of %d bytes", maxSize)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
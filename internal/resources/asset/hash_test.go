@@ -0,0 +1,31 @@
+package asset
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentHash(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/logo.png" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte("hello world"))
+	}))
+	defer server.Close()
+
+	hash, err := contentHash(context.Background(), server.Client(), server.URL+"/logo.png", 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", hash)
+
+	_, err = contentHash(context.Background(), server.Client(), server.URL+"/missing.png", 1024)
+	assert.Error(t, err)
+
+	_, err = contentHash(context.Background(), server.Client(), server.URL+"/logo.png", 5)
+	assert.ErrorContains(t, err, "exceeds the maximum size of 5 bytes")
+}
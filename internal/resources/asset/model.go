@@ -1,43 +1,50 @@
 package asset
 
 import (
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 // Asset is the main resource schema data
 type Asset struct {
-	ID          types.String `tfsdk:"id"`
-	AssetID     types.String `tfsdk:"asset_id"`
-	Version     types.Int64  `tfsdk:"version"`
-	SpaceID     types.String `tfsdk:"space_id"`
-	Environment types.String `tfsdk:"environment"`
-	Fields      *AssetFields `tfsdk:"fields"`
-	Published   types.Bool   `tfsdk:"published"`
-	Archived    types.Bool   `tfsdk:"archived"`
+	ID               types.String `tfsdk:"id"`
+	AssetID          types.String `tfsdk:"asset_id"`
+	Version          types.Int64  `tfsdk:"version"`
+	SpaceID          types.String `tfsdk:"space_id"`
+	Environment      types.String `tfsdk:"environment"`
+	Fields           *AssetFields `tfsdk:"fields"`
+	Published        types.Bool   `tfsdk:"published"`
+	Archived         types.Bool   `tfsdk:"archived"`
+	PublishedVersion types.Int64  `tfsdk:"published_version"`
+	Status           types.String `tfsdk:"status"`
+	Tags             types.Set    `tfsdk:"tags"`
+	TagsAll          types.Set    `tfsdk:"tags_all"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	AdoptExisting    types.Bool   `tfsdk:"adopt_existing"`
 }
 
+// AssetFields holds the localized fields of an asset, keyed by locale code
 type AssetFields struct {
-	Title       []LocalizedField    `tfsdk:"title"`
-	Description []LocalizedField    `tfsdk:"description"`
-	File        []LocalizedFileItem `tfsdk:"file"`
-}
-
-type LocalizedField struct {
-	Content types.String `tfsdk:"content"`
-	Locale  types.String `tfsdk:"locale"`
+	Title       map[string]types.String      `tfsdk:"title"`
+	Description map[string]types.String      `tfsdk:"description"`
+	File        map[string]LocalizedFileItem `tfsdk:"file"`
 }
 
 type LocalizedFileItem struct {
-	Locale      types.String `tfsdk:"locale"`
-	Upload      types.String `tfsdk:"upload"`
-	URL         types.String `tfsdk:"url"`
-	FileName    types.String `tfsdk:"file_name"`
-	ContentType types.String `tfsdk:"content_type"`
-	FileSize    types.Int64  `tfsdk:"filesize"`
-	ImageWidth  types.Int64  `tfsdk:"image_width"`
-	ImageHeight types.Int64  `tfsdk:"image_height"`
+	Upload         types.String `tfsdk:"upload"`
+	UploadChecksum types.String `tfsdk:"upload_checksum"`
+	URL            types.String `tfsdk:"url"`
+	FileName       types.String `tfsdk:"file_name"`
+	ContentType    types.String `tfsdk:"content_type"`
+	FileSize       types.Int64  `tfsdk:"filesize"`
+	ImageWidth     types.Int64  `tfsdk:"image_width"`
+	ImageHeight    types.Int64  `tfsdk:"image_height"`
+	HashContent    types.Bool   `tfsdk:"hash_content"`
+	ContentHash    types.String `tfsdk:"content_hash"`
 }
 
 // Import populates the Asset struct from an SDK asset object
@@ -50,34 +57,26 @@ func (a *Asset) Import(asset *sdk.Asset) {
 	a.Published = types.BoolValue(asset.Sys.PublishedAt != nil)
 	a.Archived = types.BoolValue(asset.Sys.ArchivedAt != nil)
 	a.Version = types.Int64Value(asset.Sys.Version)
+	a.PublishedVersion = types.Int64PointerValue(asset.Sys.PublishedVersion)
+	a.Status = types.StringValue(utils.PublishStatus(asset.Sys.Version, asset.Sys.PublishedVersion, asset.Sys.PublishedAt != nil, asset.Sys.ArchivedAt != nil))
+	a.Tags, a.TagsAll = utils.ImportTags(asset.Metadata, a.Tags, a.TagsAll)
 
-	// Import fields
-	a.Fields = &AssetFields{
-		Title:       []LocalizedField{},
-		Description: []LocalizedField{},
-		File:        []LocalizedFileItem{},
+	previous := a.Fields
+	if previous == nil {
+		previous = &AssetFields{}
 	}
 
-	// Title
-	for locale, content := range asset.Fields.Title {
-		a.Fields.Title = append(a.Fields.Title, LocalizedField{
-			Content: types.StringValue(content),
-			Locale:  types.StringValue(locale),
-		})
+	a.Fields = &AssetFields{
+		Title:       importStrings(asset.Fields.Title, previous.Title),
+		Description: importStrings(asset.Fields.Description, previous.Description),
 	}
 
-	// Description
-	for locale, content := range asset.Fields.Description {
-		a.Fields.Description = append(a.Fields.Description, LocalizedField{
-			Content: types.StringValue(content),
-			Locale:  types.StringValue(locale),
-		})
+	if len(asset.Fields.File) > 0 || previous.File != nil {
+		a.Fields.File = make(map[string]LocalizedFileItem, len(asset.Fields.File))
 	}
 
-	// File
 	for locale, file := range asset.Fields.File {
 		fileItem := LocalizedFileItem{
-			Locale:      types.StringValue(locale),
 			ContentType: types.StringValue(file.ContentType),
 			Upload:      types.StringPointerValue(file.Upload),
 			URL:         types.StringPointerValue(file.Url),
@@ -92,53 +91,124 @@ func (a *Asset) Import(asset *sdk.Asset) {
 			}
 		}
 
-		// Add to files collection
-		a.Fields.File = append(a.Fields.File, fileItem)
+		a.Fields.File[locale] = fileItem
+	}
+}
+
+// importStrings returns the localized values of a field. A field without
+// values is null, unless it was set to an empty map before.
+func importStrings(values map[string]string, previous map[string]types.String) map[string]types.String {
+	if len(values) == 0 && previous == nil {
+		return nil
+	}
+
+	result := make(map[string]types.String, len(values))
+	for locale, value := range values {
+		result[locale] = types.StringValue(value)
 	}
+	return result
 }
 
+// Locales returns the locales of the files of the asset, sorted
+func (a *AssetFields) Locales() []string {
+	locales := make([]string, 0, len(a.File))
+	for locale := range a.File {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	return locales
+}
+
+// CopyInputValues copies the upload, upload checksum, hash_content and content
+// type of the files from the plan, since Contentful replaces them while
+// processing the files. The content hash is kept as long as the processed file
+// is the same.
 func (a *Asset) CopyInputValues(plan *Asset) {
-	uploads := map[string]string{}
-	for _, file := range plan.Fields.File {
-		if !file.Upload.IsNull() {
-			uploads[file.Locale.ValueString()] = file.Upload.ValueString()
-		}
+	if plan.Fields == nil {
+		return
 	}
-	contentTypes := map[string]string{}
-	for _, file := range plan.Fields.File {
-		if !file.ContentType.IsNull() {
-			contentTypes[file.Locale.ValueString()] = file.ContentType.ValueString()
+
+	for locale, file := range a.Fields.File {
+		input := plan.Fields.File[locale]
+		file.Upload = types.StringValue(input.Upload.ValueString())
+		file.UploadChecksum = input.UploadChecksum
+		file.HashContent = input.HashContent
+		file.ContentType = types.StringValue(input.ContentType.ValueString())
+		if input.URL.Equal(file.URL) && !input.ContentHash.IsUnknown() {
+			file.ContentHash = input.ContentHash
 		}
+		a.Fields.File[locale] = file
 	}
+}
+
+// ChangedFiles returns the locales of which the file has to be uploaded and
+// processed again, because the upload, its checksum or the file name or content
+// type changed, or because the current file was never processed.
+func (a *Asset) ChangedFiles(current *Asset) []string {
+	var changed []string
+	for _, locale := range a.Fields.Locales() {
+		file := a.Fields.File[locale]
+
+		var previous LocalizedFileItem
+		if current.Fields != nil {
+			previous = current.Fields.File[locale]
+		}
 
-	for i, file := range a.Fields.File {
-		a.Fields.File[i].Upload = types.StringValue(uploads[file.Locale.ValueString()])
+		if previous.URL.ValueString() == "" ||
+			!file.Upload.Equal(previous.Upload) ||
+			!file.UploadChecksum.Equal(previous.UploadChecksum) ||
+			!file.FileName.Equal(previous.FileName) ||
+			(!file.ContentType.IsUnknown() && !file.ContentType.Equal(previous.ContentType)) {
+			changed = append(changed, locale)
+		}
 	}
+	return changed
+}
+
+// DraftForUpdate creates an AssetCreate object for the API in which only the
+// changed files are uploaded. The other files keep their processed file.
+func (a *Asset) DraftForUpdate(current *Asset) *sdk.AssetCreate {
+	draft := a.DraftForCreate()
+
+	changed := a.ChangedFiles(current)
+	for locale, file := range draft.Fields.File {
+		if slices.Contains(changed, locale) {
+			continue
+		}
 
-	for i, file := range a.Fields.File {
-		a.Fields.File[i].ContentType = types.StringValue(contentTypes[file.Locale.ValueString()])
+		previous := current.Fields.File[locale]
+		file.Upload = nil
+		file.Url = previous.URL.ValueStringPointer()
+		if a.Fields.File[locale].ContentType.IsUnknown() {
+			file.ContentType = previous.ContentType.ValueString()
+		}
+		draft.Fields.File[locale] = file
 	}
+
+	return draft
 }
 
 // DraftForCreate creates an AssetCreate object for the API
 func (a *Asset) DraftForCreate() *sdk.AssetCreate {
 	localizedTitle := map[string]string{}
-	for _, item := range a.Fields.Title {
-		localizedTitle[item.Locale.ValueString()] = item.Content.ValueString()
-	}
-
 	localizedDescription := map[string]string{}
-	for _, item := range a.Fields.Description {
-		localizedDescription[item.Locale.ValueString()] = item.Content.ValueString()
-	}
-
 	fileData := map[string]sdk.AssetFile{}
-	for _, item := range a.Fields.File {
-		key := item.Locale.ValueString()
-		fileData[key] = sdk.AssetFile{
-			Upload:      item.Upload.ValueString(),
-			FileName:    item.FileName.ValueString(),
-			ContentType: item.ContentType.ValueString(),
+
+	if a.Fields != nil {
+		for locale, title := range a.Fields.Title {
+			localizedTitle[locale] = title.ValueString()
+		}
+
+		for locale, description := range a.Fields.Description {
+			localizedDescription[locale] = description.ValueString()
+		}
+
+		for locale, file := range a.Fields.File {
+			fileData[locale] = sdk.AssetFile{
+				Upload:      file.Upload.ValueStringPointer(),
+				FileName:    file.FileName.ValueString(),
+				ContentType: file.ContentType.ValueString(),
+			}
 		}
 	}
 
@@ -148,5 +218,6 @@ func (a *Asset) DraftForCreate() *sdk.AssetCreate {
 			Description: localizedDescription,
 			File:        fileData,
 		},
+		Metadata: utils.TagsMetadata(a.TagsAll),
 	}
 }
@@ -0,0 +1,125 @@
+package asset_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/asset"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const testAsset = `{
+	"fields": {
+		"title": {"nl-NL": "Logo NL", "en-US": "Logo", "de-DE": "Logo DE"},
+		"file": {
+			"nl-NL": {"url": "//images.ctfassets.net/logo-nl.png", "fileName": "logo-nl.png", "contentType": "image/png",
+				"details": {"size": 2048, "image": {"width": 64, "height": 32}}},
+			"en-US": {"url": "//images.ctfassets.net/logo.png", "fileName": "logo.png", "contentType": "image/png"}
+		}
+	},
+	"sys": {
+		"id": "logo", "version": 3,
+		"space": {"sys": {"id": "space", "type": "Link", "linkType": "Space"}},
+		"environment": {"sys": {"id": "master", "type": "Link", "linkType": "Environment"}}
+	}
+}`
+
+func TestAssetImport(t *testing.T) {
+	data := &sdk.Asset{}
+	assert.NoError(t, json.Unmarshal([]byte(testAsset), data))
+
+	plan := &asset.Asset{
+		Fields: &asset.AssetFields{
+			Title: map[string]types.String{
+				"en-US": types.StringValue("Logo"),
+				"nl-NL": types.StringValue("Logo NL"),
+				"de-DE": types.StringValue("Logo DE"),
+			},
+			File: map[string]asset.LocalizedFileItem{
+				"en-US": {Upload: types.StringValue("https://example.com/logo.png"), ContentType: types.StringValue("image/png")},
+				"nl-NL": {Upload: types.StringValue("https://example.com/logo-nl.png"), ContentType: types.StringValue("image/png")},
+			},
+		},
+	}
+
+	state := *plan
+	state.Import(data)
+	state.CopyInputValues(plan)
+
+	// The locales of the maps do not depend on the order of the response
+	assert.Equal(t, plan.Fields.Title, state.Fields.Title)
+	assert.Nil(t, state.Fields.Description)
+	assert.Equal(t, []string{"en-US", "nl-NL"}, state.Fields.Locales())
+
+	nl := state.Fields.File["nl-NL"]
+	assert.Equal(t, types.StringValue("https://example.com/logo-nl.png"), nl.Upload)
+	assert.Equal(t, types.StringValue("//images.ctfassets.net/logo-nl.png"), nl.URL)
+	assert.Equal(t, types.Int64Value(2048), nl.FileSize)
+	assert.Equal(t, types.Int64Value(64), nl.ImageWidth)
+
+	draft := state.DraftForCreate()
+	assert.Equal(t, map[string]string{"en-US": "Logo", "nl-NL": "Logo NL", "de-DE": "Logo DE"}, draft.Fields.Title)
+	assert.Equal(t, "https://example.com/logo.png", *draft.Fields.File["en-US"].Upload)
+}
+
+func TestAssetImport_KeepsEmptyMap(t *testing.T) {
+	data := &sdk.Asset{}
+	assert.NoError(t, json.Unmarshal([]byte(testAsset), data))
+
+	state := &asset.Asset{
+		Fields: &asset.AssetFields{
+			Description: map[string]types.String{},
+		},
+	}
+	state.Import(data)
+
+	assert.NotNil(t, state.Fields.Description)
+	assert.Empty(t, state.Fields.Description)
+}
+
+func TestAssetDraftForUpdate(t *testing.T) {
+	file := func(upload string, checksum string, url string) asset.LocalizedFileItem {
+		return asset.LocalizedFileItem{
+			Upload:         types.StringValue(upload),
+			UploadChecksum: types.StringValue(checksum),
+			URL:            types.StringValue(url),
+			FileName:       types.StringValue("logo.png"),
+			ContentType:    types.StringValue("image/png"),
+		}
+	}
+
+	current := &asset.Asset{
+		Fields: &asset.AssetFields{
+			File: map[string]asset.LocalizedFileItem{
+				"en-US": file("https://example.com/logo.png", "abc", "//images.ctfassets.net/logo.png"),
+				"nl-NL": file("https://example.com/logo-nl.png", "def", "//images.ctfassets.net/logo-nl.png"),
+				"de-DE": file("https://example.com/logo-de.png", "", ""),
+			},
+		},
+	}
+
+	plan := &asset.Asset{
+		Fields: &asset.AssetFields{
+			File: map[string]asset.LocalizedFileItem{
+				"en-US": file("https://example.com/logo.png", "abc", ""),
+				"nl-NL": file("https://example.com/logo-nl.png", "changed", ""),
+				"de-DE": file("https://example.com/logo-de.png", "", ""),
+			},
+		},
+	}
+
+	// The file of de-DE was never processed
+	assert.Equal(t, []string{"de-DE", "nl-NL"}, plan.ChangedFiles(current))
+
+	draft := plan.DraftForUpdate(current)
+	data, err := json.Marshal(draft.Fields.File)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"en-US": {"url": "//images.ctfassets.net/logo.png", "fileName": "logo.png", "contentType": "image/png"},
+		"nl-NL": {"upload": "https://example.com/logo-nl.png", "fileName": "logo.png", "contentType": "image/png"},
+		"de-DE": {"upload": "https://example.com/logo-de.png", "fileName": "logo.png", "contentType": "image/png"}
+	}`, string(data))
+}
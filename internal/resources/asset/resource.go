@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
@@ -21,9 +22,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &assetResource{}
-	_ resource.ResourceWithConfigure   = &assetResource{}
-	_ resource.ResourceWithImportState = &assetResource{}
+	_ resource.Resource                 = &assetResource{}
+	_ resource.ResourceWithConfigure    = &assetResource{}
+	_ resource.ResourceWithImportState  = &assetResource{}
+	_ resource.ResourceWithModifyPlan   = &assetResource{}
+	_ resource.ResourceWithUpgradeState = &assetResource{}
 )
 
 func NewAssetResource() resource.Resource {
@@ -32,7 +35,10 @@ func NewAssetResource() resource.Resource {
 
 // assetResource is the resource implementation.
 type assetResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
+	onDestroy     string
+	defaultTags   []string
 }
 
 func (e *assetResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -41,6 +47,7 @@ func (e *assetResource) Metadata(_ context.Context, request resource.MetadataReq
 
 func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
+		Version:     1,
 		Description: "Contentful Asset represents a media file in Contentful.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -83,53 +90,49 @@ func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Whether the asset is archived",
 			},
+			"published_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version of the asset that was last published",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				Description: "The status of the asset, one of `draft`, `changed`, `published` or `archived`. When the asset " +
+					"should be published but has changes since it was last published, it is published again on the next apply.",
+			},
+			"tags":           utils.TagsAttribute("asset"),
+			"tags_all":       utils.TagsAllAttribute("asset"),
+			"on_destroy":     utils.OnDestroyAttribute("asset"),
+			"adopt_existing": utils.AdoptExistingAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"fields": schema.SingleNestedBlock{
 				Description: "Asset fields",
-				Blocks: map[string]schema.Block{
-					"title": schema.ListNestedBlock{
-						Description: "Asset title in different locales",
-						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"content": schema.StringAttribute{
-									Required:    true,
-									Description: "The title content",
-								},
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
-								},
-							},
-						},
+				Attributes: map[string]schema.Attribute{
+					"title": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Asset title, keyed by locale code",
 					},
-					"description": schema.ListNestedBlock{
-						Description: "Asset description in different locales",
-						NestedObject: schema.NestedBlockObject{
-							Attributes: map[string]schema.Attribute{
-								"content": schema.StringAttribute{
-									Required:    true,
-									Description: "The description content",
-								},
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
-								},
-							},
-						},
+					"description": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "Asset description, keyed by locale code",
 					},
-					"file": schema.ListNestedBlock{
-						Description: "Asset file information",
-						NestedObject: schema.NestedBlockObject{
+					"file": schema.MapNestedAttribute{
+						Optional:    true,
+						Description: "Asset file, keyed by locale code",
+						NestedObject: schema.NestedAttributeObject{
 							Attributes: map[string]schema.Attribute{
-								"locale": schema.StringAttribute{
-									Required:    true,
-									Description: "The locale code",
-								},
 								"upload": schema.StringAttribute{
 									Required:    true,
 									Description: "Upload URL or ID",
 								},
+								"upload_checksum": schema.StringAttribute{
+									Optional: true,
+									Description: "Checksum or etag of the content of the upload. When it changes, the file is uploaded and " +
+										"processed again, e.g. because the content behind the upload URL changed. Can be set with " +
+										"`filesha256()` or the etag of an HTTP data source.",
+								},
 								"url": schema.StringAttribute{
 									Computed:    true,
 									Description: "URL of the uploaded file",
@@ -155,6 +158,16 @@ func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 									Computed:    true,
 									Description: "Image height in pixels",
 								},
+								"hash_content": schema.BoolAttribute{
+									Optional: true,
+									Description: "Download the processed file after it is uploaded to set `content_hash`. Files " +
+										"larger than 100 MB are not hashed.",
+								},
+								"content_hash": schema.StringAttribute{
+									Computed: true,
+									Description: "SHA256 hash of the processed file, in the same format as `filesha256()`. Only set " +
+										"when `hash_content` is enabled.",
+								},
 							},
 						},
 					},
@@ -164,6 +177,12 @@ func (e *assetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (e *assetResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	utils.PlanRepublish(ctx, request, response)
+	utils.PlanTags(ctx, e.defaultTags, request, response)
+	utils.CheckTagsExist(ctx, e.client, e.defaultTags, request, response)
+}
+
 func (e *assetResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -171,6 +190,9 @@ func (e *assetResource) Configure(_ context.Context, request resource.ConfigureR
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.adoptExisting = data.AdoptExisting
+	e.onDestroy = data.OnDestroy
+	e.defaultTags = data.DefaultTags
 }
 
 func (e *assetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -182,17 +204,35 @@ func (e *assetResource) Create(ctx context.Context, request resource.CreateReque
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Asset plan:\n %v", spew.Sdump(plan)))
 
-	// Create the asset
+	existing, err := e.adoptableAsset(ctx, &plan)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error creating asset",
+			"Could not retrieve existing asset: "+err.Error(),
+		)
+		return
+	}
+
+	// Create the asset, or update it when an existing asset is adopted
+	var params *sdk.UpdateAssetParams
+	expectedStatus := http.StatusCreated
+	if existing != nil {
+		params = &sdk.UpdateAssetParams{
+			XContentfulVersion: existing.Sys.Version,
+		}
+		expectedStatus = http.StatusOK
+	}
+
 	draft := plan.DraftForCreate()
 	resp, err := e.client.UpdateAssetWithResponse(
 		ctx,
 		plan.SpaceID.ValueString(),
 		plan.Environment.ValueString(),
 		plan.AssetID.ValueString(),
-		nil,
+		params,
 		*draft,
 	)
-	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+	if err := utils.CheckClientResponse(resp, err, expectedStatus); err != nil {
 		response.Diagnostics.AddError(
 			"Error creating asset",
 			"Could not create asset with id: "+err.Error(),
@@ -200,16 +240,22 @@ func (e *assetResource) Create(ctx context.Context, request resource.CreateReque
 		return
 	}
 	asset := resp.JSON201
+	if existing != nil {
+		asset = resp.JSON200
+		utils.AddAdoptedWarning(&response.Diagnostics, "asset", plan.AssetID.ValueString())
+	}
 
 	state := plan
 	state.Import(asset)
+	state.CopyInputValues(&plan)
 
 	tflog.Debug(ctx, fmt.Sprintf("Asset created with ID: %s\n %v", state.ID.ValueString(), spew.Sdump(state)))
 
-	if diag := e.processAsset(ctx, &state); diag != nil {
+	if diag := e.processAsset(ctx, &state, state.Fields.Locales()); diag != nil {
 		response.Diagnostics.Append(diag)
 		return
 	}
+	response.Diagnostics.Append(e.hashFiles(ctx, &state)...)
 
 	// Handle publishing/archiving
 	if err := e.setAssetState(ctx, &state, &plan); err != nil {
@@ -251,13 +297,16 @@ func (e *assetResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
+	previous := state
+
 	// Create update parameters with version
 	params := &sdk.UpdateAssetParams{
 		XContentfulVersion: state.Version.ValueInt64(),
 	}
 
-	// Update the asset
-	draft := plan.DraftForCreate() // Reuse the create draft for updates
+	// Update the asset, only the changed files are uploaded again
+	draft := plan.DraftForUpdate(&state)
+	changed := plan.ChangedFiles(&state)
 	resp, err := e.client.UpdateAssetWithResponse(
 		ctx,
 		plan.SpaceID.ValueString(),
@@ -274,12 +323,21 @@ func (e *assetResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
+	state.AdoptExisting = plan.AdoptExisting
+	state.OnDestroy = plan.OnDestroy
+	state.Tags = plan.Tags
+	state.TagsAll = plan.TagsAll
 	state.Import(resp.JSON200)
 
-	if diag := e.processAsset(ctx, &state); diag != nil {
+	// The content hashes of the files which were not uploaded again are kept
+	state.CopyInputValues(&previous)
+	state.CopyInputValues(&plan)
+
+	if diag := e.processAsset(ctx, &state, changed); diag != nil {
 		response.Diagnostics.Append(diag)
 		return
 	}
+	response.Diagnostics.Append(e.hashFiles(ctx, &state)...)
 
 	// Handle publishing/archiving
 	if err := e.setAssetState(ctx, &state, &plan); err != nil {
@@ -302,6 +360,18 @@ func (e *assetResource) Delete(ctx context.Context, request resource.DeleteReque
 		return
 	}
 
+	// The asset is kept in Contentful unless it should be deleted, it is only
+	// removed from the state
+	if onDestroy := utils.OnDestroy(e.onDestroy, state.OnDestroy); onDestroy != utils.OnDestroyDelete {
+		if err := e.keepAsset(ctx, &state, onDestroy); err != nil {
+			response.Diagnostics.AddError(
+				"Error deleting asset",
+				"Could not "+onDestroy+" asset: "+err.Error(),
+			)
+		}
+		return
+	}
+
 	// Create delete parameters with version
 	params := &sdk.DeleteAssetParams{
 		XContentfulVersion: state.Version.ValueInt64(),
@@ -394,30 +464,35 @@ func (e *assetResource) doRead(ctx context.Context, asset *Asset, state *tfsdk.S
 }
 
 /**
- * processAsset handles the processing of the asset after creation or update.
- * Contentful will inspect the asset and generate a URL and set various other
- * attributes (filesize, image width, image height, etc.) based on the uploaded file.
+ * processAsset handles the processing of the files of the given locales after
+ * creation or update. Contentful will inspect the asset and generate a URL and
+ * set various other attributes (filesize, image width, image height, etc.)
+ * based on the uploaded file.
  *
  * Note that this can also cause conflicts, if the content/type is for example
  * resolved differently by contentful, so we do some copying of the values
  * to avoid provider errors
  */
-func (e *assetResource) processAsset(ctx context.Context, state *Asset) diag.Diagnostic {
+func (e *assetResource) processAsset(ctx context.Context, state *Asset, locales []string) diag.Diagnostic {
+	if len(locales) == 0 {
+		return nil
+	}
+
 	oldState := *state
 
 	// Process asset for each locale
-	for _, locale := range state.Fields.File {
+	for _, locale := range locales {
 		resp, err := e.client.ProcessAssetWithResponse(
 			ctx,
 			state.SpaceID.ValueString(),
 			state.Environment.ValueString(),
 			state.ID.ValueString(),
-			locale.Locale.ValueString(),
+			locale,
 		)
 		if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
 			return diag.NewErrorDiagnostic(
 				"Error processing asset",
-				fmt.Sprintf("Could not process asset for locale %s: %s", locale.Locale.ValueString(), err.Error()),
+				fmt.Sprintf("Could not process asset for locale %s: %s", locale, err.Error()),
 			)
 		}
 	}
@@ -441,9 +516,125 @@ func (e *assetResource) processAsset(ctx context.Context, state *Asset) diag.Dia
 	state.Import(resp.JSON200)
 	state.CopyInputValues(&oldState)
 
+	// The processed files are hashed again
+	for _, locale := range locales {
+		if file, ok := state.Fields.File[locale]; ok {
+			file.ContentHash = types.StringNull()
+			state.Fields.File[locale] = file
+		}
+	}
+
 	return nil
 }
 
+// hashFiles sets the content hash of the processed files which should be
+// hashed and were not hashed yet. A file which can not be downloaded is
+// reported as a warning, it is hashed again on the next apply.
+func (e *assetResource) hashFiles(ctx context.Context, state *Asset) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, locale := range state.Fields.Locales() {
+		file := state.Fields.File[locale]
+		if !file.HashContent.ValueBool() {
+			file.ContentHash = types.StringNull()
+			state.Fields.File[locale] = file
+			continue
+		}
+
+		if !file.ContentHash.IsNull() && !file.ContentHash.IsUnknown() {
+			continue
+		}
+
+		file.ContentHash = types.StringNull()
+		if file.URL.ValueString() != "" {
+			hash, err := contentHash(ctx, contentHashClient, file.URL.ValueString(), contentHashMaxSize)
+			if err != nil {
+				diags.AddWarning(
+					"Error hashing asset file",
+					fmt.Sprintf("Could not hash the file of asset %s for locale %s: %s", state.ID.ValueString(), locale, err.Error()),
+				)
+			} else {
+				file.ContentHash = types.StringValue(hash)
+			}
+		}
+
+		state.Fields.File[locale] = file
+	}
+
+	return diags
+}
+
+// keepAsset unpublishes or archives the asset, based on the on_destroy
+// setting, before it is removed from the state
+func (e *assetResource) keepAsset(ctx context.Context, state *Asset, onDestroy string) error {
+	if onDestroy == utils.OnDestroyAbandon {
+		return nil
+	}
+
+	resp, err := e.client.GetAssetWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return nil
+		}
+		return err
+	}
+	asset := resp.JSON200
+
+	if asset.Sys.PublishedAt != nil {
+		resp, err := e.client.UnpublishAssetWithResponse(
+			ctx,
+			state.SpaceID.ValueString(),
+			state.Environment.ValueString(),
+			state.ID.ValueString(),
+			&sdk.UnpublishAssetParams{
+				XContentfulVersion: asset.Sys.Version,
+			},
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return err
+		}
+		asset = resp.JSON200
+	}
+
+	if onDestroy != utils.OnDestroyArchive || asset.Sys.ArchivedAt != nil {
+		return nil
+	}
+
+	archiveResp, err := e.client.ArchiveAssetWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.ArchiveAssetParams{
+			XContentfulVersion: asset.Sys.Version,
+		},
+	)
+	return utils.CheckClientResponse(archiveResp, err, http.StatusOK)
+}
+
+// adoptableAsset returns the asset with the id of the plan when it already
+// exists and adopting existing assets is enabled, nil otherwise
+func (e *assetResource) adoptableAsset(ctx context.Context, plan *Asset) (*sdk.Asset, error) {
+	if !utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+		return nil, nil
+	}
+
+	resp, err := e.client.GetAssetWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.AssetID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
 // setAssetState handles publishing and archiving based on the desired state
 func (e *assetResource) setAssetState(ctx context.Context, state *Asset, plan *Asset) error {
 	oldState := *state
@@ -452,7 +643,10 @@ func (e *assetResource) setAssetState(ctx context.Context, state *Asset, plan *A
 	isCurrentlyPublished := state.Published.ValueBool()
 	shouldBePublished := plan.Published.ValueBool()
 
-	if shouldBePublished && !isCurrentlyPublished {
+	// A published asset with changes since its publication is published again
+	hasUnpublishedChanges := state.Status.ValueString() == utils.StatusChanged
+
+	if shouldBePublished && (!isCurrentlyPublished || hasUnpublishedChanges) {
 		publishParams := &sdk.PublishAssetParams{
 			XContentfulVersion: state.Version.ValueInt64(),
 		}
@@ -36,10 +36,13 @@ func TestAssetResource_Basic(t *testing.T) {
 			{
 				Config: testAssetConfig(spaceID, environment, assetName),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "fields.title.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "fields.title.0.content", "Asset title"),
-					resource.TestCheckResourceAttr(resourceName, "fields.description.0.content", "Asset description"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Asset title"),
+					resource.TestCheckResourceAttr(resourceName, "fields.description.en-US", "Asset description"),
+					resource.TestCheckResourceAttr(resourceName, "fields.file.en-US.file_name", "example.jpeg"),
+					resource.TestCheckResourceAttrSet(resourceName, "fields.file.en-US.content_hash"),
 					resource.TestCheckResourceAttr(resourceName, "published", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", "published"),
 					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
 					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
 						assert.NotNil(t, asset.Sys.PublishedAt, "Asset should be published")
@@ -51,9 +54,10 @@ func TestAssetResource_Basic(t *testing.T) {
 			{
 				Config: testAssetUpdateConfig(spaceID, environment, assetName),
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(resourceName, "fields.title.0.content", "Updated asset title"),
-					resource.TestCheckResourceAttr(resourceName, "fields.description.0.content", "Updated asset description"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Updated asset title"),
+					resource.TestCheckResourceAttr(resourceName, "fields.description.en-US", "Updated asset description"),
 					resource.TestCheckResourceAttr(resourceName, "published", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", "draft"),
 					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
 						assert.Nil(t, asset.Sys.PublishedAt, "Asset should not be published")
 						assert.Equal(t, "Updated asset title", asset.Fields.Title["en-US"])
@@ -80,6 +84,51 @@ func TestAssetResource_Basic(t *testing.T) {
 	})
 }
 
+func TestAssetResource_AdoptExisting(t *testing.T) {
+	assetName := fmt.Sprintf("asset-%s", hashicor_acctest.RandString(3))
+	resourceName := "contentful_asset.myasset"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulAssetDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					resp, err := client.UpdateAssetWithResponse(context.Background(), spaceID, environment, assetName, nil, sdk.AssetCreate{
+						Fields: &sdk.AssetField{
+							Title:       map[string]string{"en-US": "Existing asset title"},
+							Description: map[string]string{},
+							File:        map[string]sdk.AssetFile{},
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating asset: %d", resp.StatusCode())
+					}
+				},
+				Config: testAssetAdoptConfig(spaceID, environment, assetName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					resource.TestCheckResourceAttr(resourceName, "fields.title.en-US", "Asset title"),
+					testAccCheckContentfulAssetExists(t, resourceName, func(t *testing.T, asset *sdk.Asset) {
+						assert.Equal(t, assetName, asset.Sys.Id)
+						assert.Greater(t, asset.Sys.Version, int64(1))
+						assert.Equal(t, "Asset title", asset.Fields.Title["en-US"])
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulAssetExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		asset, err := getAssetFromState(s, resourceName)
@@ -164,19 +213,19 @@ resource "contentful_asset" "myasset" {
   environment = "%s"
   space_id = "%s"
   fields {
-    title {
-      locale = "en-US"
-      content = "Asset title"
+    title = {
+      "en-US" = "Asset title"
     }
-    description {
-      locale = "en-US"
-      content = "Asset description"
+    description = {
+      "en-US" = "Asset description"
     }
-    file {
-      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      file_name = "example.jpeg"
-      content_type = "image/jpeg"
-      locale = "en-US"
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+        hash_content = true
+      }
     }
   }
   published = true
@@ -192,19 +241,46 @@ resource "contentful_asset" "myasset" {
   environment = "%s"
   space_id = "%s"
   fields {
-    title {
-      locale = "en-US"
-      content = "Updated asset title"
+    title = {
+      "en-US" = "Updated asset title"
+    }
+    description = {
+      "en-US" = "Updated asset description"
+    }
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
+    }
+  }
+  published = false
+  archived = false
+}
+`, name, environment, spaceID)
+}
+
+func testAssetAdoptConfig(spaceID, environment, name string) string {
+	return fmt.Sprintf(`
+resource "contentful_asset" "myasset" {
+  asset_id = "%s"
+  environment = "%s"
+  space_id = "%s"
+  adopt_existing = true
+  fields {
+    title = {
+      "en-US" = "Asset title"
     }
-    description {
-      locale = "en-US"
-      content = "Updated asset description"
+    description = {
+      "en-US" = "Asset description"
     }
-    file {
-      upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
-      file_name = "example.jpeg"
-      content_type = "image/jpeg"
-      locale = "en-US"
+    file = {
+      "en-US" = {
+        upload = "https://images.ctfassets.net/fo9twyrwpveg/2VQx7vz73aMEYi20MMgCk0/66e502115b1f1f973a944b4bd2cc536f/IC-1H_Modern_Stack_Website.svg"
+        file_name = "example.jpeg"
+        content_type = "image/jpeg"
+      }
     }
   }
   published = false
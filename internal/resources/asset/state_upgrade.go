@@ -0,0 +1,84 @@
+package asset
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func (e *assetResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the title, description and file as lists with a
+		// locale, version 1 stores them in maps keyed by the locale.
+		0: {
+			StateUpgrader: func(_ context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				if request.RawState == nil {
+					response.Diagnostics.AddError("Error upgrading asset state", "No prior state available to upgrade")
+					return
+				}
+
+				upgraded, err := upgradeFieldsV0(request.RawState.JSON)
+				if err != nil {
+					response.Diagnostics.AddError("Error upgrading asset state", "Could not upgrade asset state: "+err.Error())
+					return
+				}
+
+				response.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeFieldsV0 converts the raw json state of version 0, in which the
+// title, description and file of the `fields` block are lists of objects with
+// a locale, to the maps keyed by locale of version 1. Empty lists become null.
+func upgradeFieldsV0(raw []byte) ([]byte, error) {
+	state := map[string]any{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+
+	fields, ok := state["fields"].(map[string]any)
+	if !ok {
+		return raw, nil
+	}
+
+	for _, name := range []string{"title", "description", "file"} {
+		items, _ := fields[name].([]any)
+		if len(items) == 0 {
+			fields[name] = nil
+			continue
+		}
+
+		values := make(map[string]any, len(items))
+		for i, item := range items {
+			value, ok := item.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("unexpected value for %s at index %d", name, i)
+			}
+
+			locale, ok := value["locale"].(string)
+			if !ok || locale == "" {
+				return nil, fmt.Errorf("%s at index %d has no locale", name, i)
+			}
+
+			if _, exists := values[locale]; exists {
+				return nil, fmt.Errorf("%s is defined more than once for locale %q", name, locale)
+			}
+
+			delete(value, "locale")
+			if name == "file" {
+				values[locale] = value
+			} else {
+				values[locale] = value["content"]
+			}
+		}
+
+		fields[name] = values
+	}
+
+	return json.Marshal(state)
+}
@@ -0,0 +1,56 @@
+package asset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeFieldsV0(t *testing.T) {
+	raw := []byte(`{
+		"id": "logo",
+		"fields": {
+			"title": [{"locale": "nl-NL", "content": "Logo NL"}, {"locale": "en-US", "content": "Logo"}],
+			"description": [],
+			"file": [{
+				"locale": "en-US", "upload": "https://example.com/logo.png", "url": "//images.ctfassets.net/logo.png",
+				"file_name": "logo.png", "content_type": "image/png", "filesize": 1024, "image_width": 64, "image_height": 32
+			}]
+		}
+	}`)
+
+	upgraded, err := upgradeFieldsV0(raw)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id": "logo",
+		"fields": {
+			"title": {"en-US": "Logo", "nl-NL": "Logo NL"},
+			"description": null,
+			"file": {
+				"en-US": {
+					"upload": "https://example.com/logo.png", "url": "//images.ctfassets.net/logo.png",
+					"file_name": "logo.png", "content_type": "image/png", "filesize": 1024, "image_width": 64, "image_height": 32
+				}
+			}
+		}
+	}`, string(upgraded))
+}
+
+func TestUpgradeFieldsV0WithoutFields(t *testing.T) {
+	raw := []byte(`{"id": "logo", "fields": null}`)
+
+	upgraded, err := upgradeFieldsV0(raw)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"id": "logo", "fields": null}`, string(upgraded))
+}
+
+func TestUpgradeFieldsV0ReturnsErrorForDuplicateLocale(t *testing.T) {
+	raw := []byte(`{"fields": {"title": [{"locale": "en-US", "content": "a"}, {"locale": "en-US", "content": "b"}]}}`)
+
+	_, err := upgradeFieldsV0(raw)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `title is defined more than once for locale "en-US"`)
+}
@@ -0,0 +1,226 @@
+package contenttype
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// FieldEditor is the control of a field in the editor interface of the
+// content type
+type FieldEditor struct {
+	WidgetId        types.String         `tfsdk:"widget_id"`
+	WidgetNamespace types.String         `tfsdk:"widget_namespace"`
+	Settings        jsontypes.Normalized `tfsdk:"settings"`
+}
+
+// EditorWidget is an item of the sidebar or one of the entry editors of the
+// editor interface
+type EditorWidget struct {
+	WidgetId        types.String         `tfsdk:"widget_id"`
+	WidgetNamespace types.String         `tfsdk:"widget_namespace"`
+	Settings        jsontypes.Normalized `tfsdk:"settings"`
+	Disabled        types.Bool           `tfsdk:"disabled"`
+}
+
+// hasEditorInterface returns whether any part of the editor interface is
+// configured on the content type. The editor interface is left alone
+// otherwise, so it can still be managed with contentful_editor_interface.
+func (c *ContentType) hasEditorInterface() bool {
+	if c.Sidebar != nil || c.Editors != nil {
+		return true
+	}
+
+	for _, field := range c.Fields {
+		if field.Editor != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// editorInterfaceEqual returns whether both content types configure the same
+// editor interface
+func (c *ContentType) editorInterfaceEqual(o *ContentType) bool {
+	editors := func(ct *ContentType) map[string]*FieldEditor {
+		result := map[string]*FieldEditor{}
+		for id, field := range ct.Fields {
+			result[id] = field.Editor
+		}
+		return result
+	}
+
+	return reflect.DeepEqual(editors(c), editors(o)) &&
+		reflect.DeepEqual(c.Sidebar, o.Sidebar) &&
+		reflect.DeepEqual(c.Editors, o.Editors)
+}
+
+func draftEditorSettings(settings jsontypes.Normalized) *sdk.EditorInterfaceSettings {
+	if settings.IsNull() || settings.IsUnknown() {
+		return nil
+	}
+
+	result := &sdk.EditorInterfaceSettings{}
+	if diags := settings.Unmarshal(result); diags.HasError() {
+		return nil
+	}
+
+	return result
+}
+
+// importEditorSettings converts the settings returned by Contentful. Empty
+// settings are stored as null when they were not configured.
+func importEditorSettings(previous jsontypes.Normalized, settings *sdk.EditorInterfaceSettings) jsontypes.Normalized {
+	if settings == nil {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	data, err := json.Marshal(settings)
+	if err != nil {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	if string(data) == "{}" && previous.IsNull() {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(data))
+}
+
+// EditorInterfaceDraft builds the editor interface based on the current one.
+// Controls of fields without a configured editor, and the sidebar and editors
+// when not configured, are kept as they are.
+func (c *ContentType) EditorInterfaceDraft(current *sdk.EditorInterface) sdk.EditorInterfaceUpdate {
+	result := sdk.EditorInterfaceUpdate{
+		Sidebar: current.Sidebar,
+		Editors: current.Editors,
+	}
+
+	controls := make([]sdk.EditorInterfaceControl, 0, len(c.Fields))
+	for _, field := range c.orderedFields() {
+		idx := pie.FindFirstUsing(current.Controls, func(control sdk.EditorInterfaceControl) bool {
+			return control.FieldId == field.Id.ValueString()
+		})
+
+		control := sdk.EditorInterfaceControl{
+			FieldId: field.Id.ValueString(),
+		}
+		if idx != -1 {
+			control = current.Controls[idx]
+		}
+
+		if field.Editor != nil {
+			control.WidgetId = field.Editor.WidgetId.ValueStringPointer()
+			control.WidgetNamespace = utils.Pointer(sdk.EditorInterfaceControlWidgetNamespaceBuiltin)
+			if !field.Editor.WidgetNamespace.IsNull() && !field.Editor.WidgetNamespace.IsUnknown() {
+				control.WidgetNamespace = utils.Pointer(sdk.EditorInterfaceControlWidgetNamespace(field.Editor.WidgetNamespace.ValueString()))
+			}
+			control.Settings = draftEditorSettings(field.Editor.Settings)
+		}
+
+		controls = append(controls, control)
+	}
+	result.Controls = controls
+
+	if c.Sidebar != nil {
+		sidebar := pie.Map(c.Sidebar, func(w EditorWidget) sdk.EditorInterfaceSidebarItem {
+			return sdk.EditorInterfaceSidebarItem{
+				WidgetId:        w.WidgetId.ValueStringPointer(),
+				WidgetNamespace: utils.Pointer(sdk.EditorInterfaceSidebarItemWidgetNamespace(w.WidgetNamespace.ValueString())),
+				Disabled:        w.Disabled.ValueBoolPointer(),
+				Settings:        draftEditorSettings(w.Settings),
+			}
+		})
+		result.Sidebar = &sidebar
+	}
+
+	if c.Editors != nil {
+		editors := pie.Map(c.Editors, func(w EditorWidget) sdk.EditorInterfaceEditor {
+			return sdk.EditorInterfaceEditor{
+				WidgetId:        w.WidgetId.ValueStringPointer(),
+				WidgetNamespace: utils.Pointer(sdk.EditorInterfaceEditorWidgetNamespace(w.WidgetNamespace.ValueString())),
+				Disabled:        w.Disabled.ValueBoolPointer(),
+				Settings:        draftEditorSettings(w.Settings),
+			}
+		})
+		result.Editors = &editors
+	}
+
+	return result
+}
+
+// ImportEditorInterface reads the parts of the editor interface that are
+// configured on the content type
+func (c *ContentType) ImportEditorInterface(n *sdk.EditorInterface) {
+	for id, field := range c.Fields {
+		if field.Editor == nil {
+			continue
+		}
+
+		idx := pie.FindFirstUsing(n.Controls, func(control sdk.EditorInterfaceControl) bool {
+			return control.FieldId == id
+		})
+		if idx == -1 {
+			continue
+		}
+
+		control := n.Controls[idx]
+		editor := &FieldEditor{
+			WidgetId:        types.StringPointerValue(control.WidgetId),
+			WidgetNamespace: types.StringNull(),
+			Settings:        importEditorSettings(field.Editor.Settings, control.Settings),
+		}
+		if control.WidgetNamespace != nil {
+			editor.WidgetNamespace = types.StringValue(string(*control.WidgetNamespace))
+		}
+
+		field.Editor = editor
+		c.Fields[id] = field
+	}
+
+	if c.Sidebar != nil {
+		var sidebar []sdk.EditorInterfaceSidebarItem
+		if n.Sidebar != nil {
+			sidebar = *n.Sidebar
+		}
+
+		c.Sidebar = pie.Map(sidebar, func(item sdk.EditorInterfaceSidebarItem) EditorWidget {
+			return importEditorWidget(item.WidgetId, (*string)(item.WidgetNamespace), item.Disabled, item.Settings)
+		})
+	}
+
+	if c.Editors != nil {
+		var editors []sdk.EditorInterfaceEditor
+		if n.Editors != nil {
+			editors = *n.Editors
+		}
+
+		c.Editors = pie.Map(editors, func(item sdk.EditorInterfaceEditor) EditorWidget {
+			return importEditorWidget(item.WidgetId, (*string)(item.WidgetNamespace), item.Disabled, item.Settings)
+		})
+	}
+}
+
+func importEditorWidget(widgetId *string, widgetNamespace *string, disabled *bool, settings *sdk.EditorInterfaceSettings) EditorWidget {
+	widget := EditorWidget{
+		WidgetId:        types.StringPointerValue(widgetId),
+		WidgetNamespace: types.StringPointerValue(widgetNamespace),
+		Disabled:        types.BoolValue(disabled != nil && *disabled),
+		Settings:        jsontypes.NewNormalizedValue("{}"),
+	}
+
+	if settings != nil {
+		if data, err := json.Marshal(settings); err == nil {
+			widget.Settings = jsontypes.NewNormalizedValue(string(data))
+		}
+	}
+
+	return widget
+}
@@ -0,0 +1,109 @@
+package contenttype
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestEditorInterfaceDraftKeepsUnmanagedControls(t *testing.T) {
+	contentType := ContentType{
+		Fields: map[string]Field{
+			"title": {
+				Position: types.Int64Value(0),
+				Editor: &FieldEditor{
+					WidgetId:        types.StringValue("singleLine"),
+					WidgetNamespace: types.StringValue("builtin"),
+					Settings:        jsontypes.NewNormalizedValue(`{"helpText":"The title"}`),
+				},
+			},
+			"slug": {
+				Position: types.Int64Value(1),
+			},
+			"body": {
+				Position: types.Int64Value(2),
+			},
+		},
+	}
+
+	current := &sdk.EditorInterface{
+		Controls: []sdk.EditorInterfaceControl{
+			{FieldId: "title", WidgetId: utils.Pointer("multipleLine")},
+			{FieldId: "slug", WidgetId: utils.Pointer("slugEditor")},
+			{FieldId: "removed", WidgetId: utils.Pointer("singleLine")},
+		},
+		Sidebar: &[]sdk.EditorInterfaceSidebarItem{
+			{WidgetId: utils.Pointer("publication-widget")},
+		},
+	}
+
+	draft := contentType.EditorInterfaceDraft(current)
+
+	assert.Len(t, draft.Controls, 3)
+	assert.Equal(t, "title", draft.Controls[0].FieldId)
+	assert.Equal(t, "singleLine", *draft.Controls[0].WidgetId)
+	assert.Equal(t, "The title", *draft.Controls[0].Settings.HelpText)
+	assert.Equal(t, sdk.EditorInterfaceControl{FieldId: "slug", WidgetId: utils.Pointer("slugEditor")}, draft.Controls[1])
+	assert.Equal(t, sdk.EditorInterfaceControl{FieldId: "body"}, draft.Controls[2])
+	assert.Equal(t, current.Sidebar, draft.Sidebar)
+	assert.Nil(t, draft.Editors)
+}
+
+func TestImportEditorInterfaceOnlyReadsConfiguredParts(t *testing.T) {
+	contentType := ContentType{
+		Fields: map[string]Field{
+			"title": {
+				Editor: &FieldEditor{
+					WidgetId:        types.StringValue("singleLine"),
+					WidgetNamespace: types.StringValue("builtin"),
+					Settings:        jsontypes.NewNormalizedNull(),
+				},
+			},
+			"slug": {},
+		},
+		Editors: []EditorWidget{},
+	}
+
+	contentType.ImportEditorInterface(&sdk.EditorInterface{
+		Controls: []sdk.EditorInterfaceControl{
+			{
+				FieldId:         "title",
+				WidgetId:        utils.Pointer("multipleLine"),
+				WidgetNamespace: utils.Pointer(sdk.EditorInterfaceControlWidgetNamespaceBuiltin),
+				Settings:        &sdk.EditorInterfaceSettings{},
+			},
+			{FieldId: "slug", WidgetId: utils.Pointer("slugEditor")},
+		},
+		Sidebar: &[]sdk.EditorInterfaceSidebarItem{
+			{WidgetId: utils.Pointer("publication-widget")},
+		},
+		Editors: &[]sdk.EditorInterfaceEditor{
+			{
+				WidgetId:        utils.Pointer("default-editor"),
+				WidgetNamespace: utils.Pointer(sdk.EditorInterfaceEditorWidgetNamespace("editor-builtin")),
+				Disabled:        utils.Pointer(true),
+			},
+		},
+	})
+
+	assert.Equal(t, &FieldEditor{
+		WidgetId:        types.StringValue("multipleLine"),
+		WidgetNamespace: types.StringValue("builtin"),
+		Settings:        jsontypes.NewNormalizedNull(),
+	}, contentType.Fields["title"].Editor)
+	assert.Nil(t, contentType.Fields["slug"].Editor)
+	assert.Nil(t, contentType.Sidebar)
+	assert.Equal(t, []EditorWidget{
+		{
+			WidgetId:        types.StringValue("default-editor"),
+			WidgetNamespace: types.StringValue("editor-builtin"),
+			Disabled:        types.BoolValue(true),
+			Settings:        jsontypes.NewNormalizedValue("{}"),
+		},
+	}, contentType.Editors)
+}
@@ -3,6 +3,7 @@ package contenttype
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	"github.com/elliotchance/pie/v2"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -14,28 +15,207 @@ import (
 
 // ContentType is the main resource schema data
 type ContentType struct {
-	ID           types.String `tfsdk:"id"`
-	SpaceId      types.String `tfsdk:"space_id"`
-	Environment  types.String `tfsdk:"environment"`
-	Name         types.String `tfsdk:"name"`
-	DisplayField types.String `tfsdk:"display_field"`
-	Description  types.String `tfsdk:"description"`
-	Version      types.Int64  `tfsdk:"version"`
-	Fields       []Field      `tfsdk:"fields"`
+	ID            types.String     `tfsdk:"id"`
+	SpaceId       types.String     `tfsdk:"space_id"`
+	Environment   types.String     `tfsdk:"environment"`
+	Name          types.String     `tfsdk:"name"`
+	DisplayField  types.String     `tfsdk:"display_field"`
+	Description   types.String     `tfsdk:"description"`
+	Version       types.Int64      `tfsdk:"version"`
+	Fields        map[string]Field `tfsdk:"field"`
+	Metadata      *Metadata        `tfsdk:"metadata"`
+	Sidebar       []EditorWidget   `tfsdk:"sidebar"`
+	Editors       []EditorWidget   `tfsdk:"editors"`
+	AdoptExisting types.Bool       `tfsdk:"adopt_existing"`
+}
+
+type Metadata struct {
+	Annotations      []types.String            `tfsdk:"annotations"`
+	FieldAnnotations map[string][]types.String `tfsdk:"field_annotations"`
+	Taxonomy         []TaxonomyValidation      `tfsdk:"taxonomy"`
+}
+
+type TaxonomyValidation struct {
+	ConceptSchemeId types.String `tfsdk:"concept_scheme_id"`
+	ConceptId       types.String `tfsdk:"concept_id"`
+	Required        types.Bool   `tfsdk:"required"`
+}
+
+func annotationLinks(annotations []types.String) []sdk.AnnotationLink {
+	return pie.Map(annotations, func(annotation types.String) sdk.AnnotationLink {
+		link := sdk.AnnotationLink{}
+		link.Sys.Id = annotation.ValueString()
+		link.Sys.Type = "Link"
+		link.Sys.LinkType = "Annotation"
+		return link
+	})
+}
+
+func annotationIds(links []sdk.AnnotationLink) []types.String {
+	return pie.Map(links, func(link sdk.AnnotationLink) types.String {
+		return types.StringValue(link.Sys.Id)
+	})
+}
+
+// Draft returns the metadata to send to Contentful. Only the configured
+// attributes are written, the remote values of the other attributes are kept.
+func (m *Metadata) Draft(remote *sdk.ContentTypeMetadata) *sdk.ContentTypeMetadata {
+	if m == nil {
+		return remote
+	}
+
+	result := &sdk.ContentTypeMetadata{}
+	annotations := &sdk.ContentTypeAnnotations{}
+	if remote != nil {
+		result.Taxonomy = remote.Taxonomy
+		if remote.Annotations != nil {
+			*annotations = *remote.Annotations
+		}
+	}
+
+	if m.Annotations != nil {
+		annotations.ContentType = utils.Pointer(annotationLinks(m.Annotations))
+	}
+
+	if m.FieldAnnotations != nil {
+		fieldAnnotations := map[string][]sdk.AnnotationLink{}
+		for fieldId, ids := range m.FieldAnnotations {
+			fieldAnnotations[fieldId] = annotationLinks(ids)
+		}
+		annotations.ContentTypeField = &fieldAnnotations
+	}
+
+	if annotations.ContentType != nil || annotations.ContentTypeField != nil {
+		result.Annotations = annotations
+	}
+
+	if m.Taxonomy != nil {
+		taxonomy := pie.Map(m.Taxonomy, func(t TaxonomyValidation) sdk.TaxonomyValidation {
+			validation := sdk.TaxonomyValidation{
+				Required: t.Required.ValueBoolPointer(),
+			}
+			validation.Sys.Type = "Link"
+
+			if !t.ConceptSchemeId.IsNull() {
+				validation.Sys.Id = t.ConceptSchemeId.ValueString()
+				validation.Sys.LinkType = sdk.TaxonomyConceptScheme
+			} else {
+				validation.Sys.Id = t.ConceptId.ValueString()
+				validation.Sys.LinkType = sdk.TaxonomyConcept
+			}
+
+			return validation
+		})
+		result.Taxonomy = &taxonomy
+	}
+
+	return result
+}
+
+// newMetadata returns the metadata with every attribute which is set in
+// Contentful, it is used when a content type is imported.
+func newMetadata(n *sdk.ContentTypeMetadata) *Metadata {
+	if n == nil {
+		return nil
+	}
+
+	m := &Metadata{}
+	if n.Annotations != nil && n.Annotations.ContentType != nil && len(*n.Annotations.ContentType) > 0 {
+		m.Annotations = []types.String{}
+	}
+	if n.Annotations != nil && n.Annotations.ContentTypeField != nil && len(*n.Annotations.ContentTypeField) > 0 {
+		m.FieldAnnotations = map[string][]types.String{}
+	}
+	if n.Taxonomy != nil && len(*n.Taxonomy) > 0 {
+		m.Taxonomy = []TaxonomyValidation{}
+	}
+
+	if m.Annotations == nil && m.FieldAnnotations == nil && m.Taxonomy == nil {
+		return nil
+	}
+
+	m.Import(n)
+	return m
+}
+
+// Import reads the configured attributes of the metadata returned by
+// Contentful, the attributes which are not configured are not managed and
+// stay null.
+func (m *Metadata) Import(n *sdk.ContentTypeMetadata) {
+	var annotations []sdk.AnnotationLink
+	var fieldAnnotations map[string][]sdk.AnnotationLink
+	var taxonomy []sdk.TaxonomyValidation
+
+	if n != nil {
+		if n.Annotations != nil && n.Annotations.ContentType != nil {
+			annotations = *n.Annotations.ContentType
+		}
+		if n.Annotations != nil && n.Annotations.ContentTypeField != nil {
+			fieldAnnotations = *n.Annotations.ContentTypeField
+		}
+		if n.Taxonomy != nil {
+			taxonomy = *n.Taxonomy
+		}
+	}
+
+	if m.Annotations != nil {
+		m.Annotations = annotationIds(annotations)
+	}
+
+	if m.FieldAnnotations != nil {
+		m.FieldAnnotations = map[string][]types.String{}
+		for fieldId, links := range fieldAnnotations {
+			m.FieldAnnotations[fieldId] = annotationIds(links)
+		}
+	}
+
+	if m.Taxonomy != nil {
+		m.Taxonomy = pie.Map(taxonomy, func(t sdk.TaxonomyValidation) TaxonomyValidation {
+			validation := TaxonomyValidation{
+				ConceptSchemeId: types.StringNull(),
+				ConceptId:       types.StringNull(),
+				Required:        types.BoolValue(t.Required != nil && *t.Required),
+			}
+
+			if t.Sys.LinkType == sdk.TaxonomyConceptScheme {
+				validation.ConceptSchemeId = types.StringValue(t.Sys.Id)
+			} else {
+				validation.ConceptId = types.StringValue(t.Sys.Id)
+			}
+
+			return validation
+		})
+	}
+}
+
+func (m *Metadata) Equal(n *sdk.ContentTypeMetadata) bool {
+	remote := &Metadata{
+		Annotations:      m.Annotations,
+		FieldAnnotations: m.FieldAnnotations,
+		Taxonomy:         m.Taxonomy,
+	}
+	remote.Import(n)
+
+	return reflect.DeepEqual(m.Draft(n), remote.Draft(n))
 }
 
 type Field struct {
-	Id           types.String  `tfsdk:"id"`
-	Name         types.String  `tfsdk:"name"`
-	Type         types.String  `tfsdk:"type"`
-	LinkType     types.String  `tfsdk:"link_type"`
-	Required     types.Bool    `tfsdk:"required"`
-	Localized    types.Bool    `tfsdk:"localized"`
-	Disabled     types.Bool    `tfsdk:"disabled"`
-	Omitted      types.Bool    `tfsdk:"omitted"`
-	Validations  []Validation  `tfsdk:"validations"`
-	Items        *Items        `tfsdk:"items"`
-	DefaultValue *DefaultValue `tfsdk:"default_value"`
+	// Id is the key of the field in the `field` map and is filled in by
+	// orderedFields, it is not part of the nested schema.
+	Id               types.String      `tfsdk:"-"`
+	Position         types.Int64       `tfsdk:"position"`
+	Name             types.String      `tfsdk:"name"`
+	Type             types.String      `tfsdk:"type"`
+	LinkType         types.String      `tfsdk:"link_type"`
+	AllowedResources []AllowedResource `tfsdk:"allowed_resources"`
+	Required         types.Bool        `tfsdk:"required"`
+	Localized        types.Bool        `tfsdk:"localized"`
+	Disabled         types.Bool        `tfsdk:"disabled"`
+	Omitted          types.Bool        `tfsdk:"omitted"`
+	Validations      []Validation      `tfsdk:"validations"`
+	Items            *Items            `tfsdk:"items"`
+	DefaultValue     *DefaultValue     `tfsdk:"default_value"`
+	Editor           *FieldEditor      `tfsdk:"editor"`
 }
 
 type DefaultValue struct {
@@ -500,9 +680,13 @@ func MapInternalAllowedResource(allowedResource AllowedResource) sdk.AllowedReso
 }
 
 func MapSdkAllowedResource(allowedResource sdk.AllowedResource) AllowedResource {
-	var contentTypes = pie.Map(*allowedResource.ContentTypes, func(t string) types.String {
-		return types.StringValue(t)
-	})
+	// External resource types of apps don't have content types
+	var contentTypes []types.String
+	if allowedResource.ContentTypes != nil {
+		contentTypes = pie.Map(*allowedResource.ContentTypes, func(t string) types.String {
+			return types.StringValue(t)
+		})
+	}
 
 	return AllowedResource{
 		Type:         types.StringPointerValue(allowedResource.Type),
@@ -511,6 +695,29 @@ func MapSdkAllowedResource(allowedResource sdk.AllowedResource) AllowedResource
 	}
 }
 
+// draftAllowedResources converts the allowed resources of a ResourceLink
+// field or item, nil is returned when none are configured.
+func draftAllowedResources(allowedResources []AllowedResource) *[]sdk.AllowedResource {
+	if len(allowedResources) == 0 {
+		return nil
+	}
+
+	ar := pie.Map(allowedResources, MapInternalAllowedResource)
+	return &ar
+}
+
+func importAllowedResources(allowedResources *[]sdk.AllowedResource) []AllowedResource {
+	if allowedResources == nil || len(*allowedResources) == 0 {
+		return nil
+	}
+
+	return pie.Map(*allowedResources, MapSdkAllowedResource)
+}
+
+func compareAllowedResources(allowedResources []AllowedResource, n *[]sdk.AllowedResource) bool {
+	return reflect.DeepEqual(draftAllowedResources(allowedResources), draftAllowedResources(importAllowedResources(n)))
+}
+
 func (f *Field) Equal(n sdk.Field) bool {
 
 	if string(n.Type) != f.Type.ValueString() {
@@ -549,6 +756,10 @@ func (f *Field) Equal(n sdk.Field) bool {
 		return false
 	}
 
+	if !compareAllowedResources(f.AllowedResources, n.AllowedResources) {
+		return false
+	}
+
 	if f.Items == nil && n.Items != nil {
 		return false
 	}
@@ -602,6 +813,10 @@ func (f *Field) ToNative() (*sdk.Field, error) {
 		contentfulField.LinkType = utils.Pointer(sdk.FieldLinkType(f.LinkType.ValueString()))
 	}
 
+	if contentfulField.Type == sdk.FieldTypeResourceLink {
+		contentfulField.AllowedResources = draftAllowedResources(f.AllowedResources)
+	}
+
 	if contentfulField.Type == sdk.FieldTypeArray {
 		items, errItem := f.Items.ToNative()
 
@@ -658,6 +873,8 @@ func (f *Field) Import(n sdk.Field) error {
 		f.LinkType = types.StringValue(string(*n.LinkType))
 	}
 
+	f.AllowedResources = importAllowedResources(n.AllowedResources)
+
 	defaultValueType, err := getTypeOfMap(n.DefaultValue)
 	if err != nil {
 		return err
@@ -725,6 +942,23 @@ func (f *Field) Import(n sdk.Field) error {
 				Type:        types.StringValue(itemType),
 				Validations: itemValidations,
 			}
+		} else if itemType == "ResourceLink" {
+			resourceLinkItem, err := n.Items.AsFieldItemResourceLink()
+			if err != nil {
+				return err
+			}
+
+			itemValidations, err := getValidations(resourceLinkItem.Validations)
+			if err != nil {
+				return err
+			}
+
+			f.Items = &Items{
+				Type:             types.StringValue(itemType),
+				LinkType:         types.StringNull(),
+				AllowedResources: importAllowedResources(&resourceLinkItem.AllowedResources),
+				Validations:      itemValidations,
+			}
 		} else {
 			linkItem, err := n.Items.AsFieldItemLink()
 			if err != nil {
@@ -753,9 +987,10 @@ func (f *Field) Import(n sdk.Field) error {
 }
 
 type Items struct {
-	Type        types.String `tfsdk:"type"`
-	LinkType    types.String `tfsdk:"link_type"`
-	Validations []Validation `tfsdk:"validations"`
+	Type             types.String      `tfsdk:"type"`
+	LinkType         types.String      `tfsdk:"link_type"`
+	AllowedResources []AllowedResource `tfsdk:"allowed_resources"`
+	Validations      []Validation      `tfsdk:"validations"`
 }
 
 func (i *Items) ToNative() (*sdk.FieldItem, error) {
@@ -779,7 +1014,25 @@ func (i *Items) ToNative() (*sdk.FieldItem, error) {
 		return &item, nil
 	}
 
-	if fieldType == "Link" || fieldType == "ResourceLink" {
+	if fieldType == "ResourceLink" {
+		var allowedResources []sdk.AllowedResource
+		if ar := draftAllowedResources(i.AllowedResources); ar != nil {
+			allowedResources = *ar
+		}
+
+		err := item.FromFieldItemResourceLink(sdk.FieldItemResourceLink{
+			Validations:      &validations,
+			AllowedResources: allowedResources,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &item, nil
+	}
+
+	if fieldType == "Link" {
 		err := item.FromFieldItemLink(sdk.FieldItemLink{
 			Validations: &validations,
 			LinkType:    sdk.FieldItemLinkLinkType(i.LinkType.ValueString()),
@@ -810,7 +1063,21 @@ func (i *Items) Equal(n *sdk.FieldItem) bool {
 		return false
 	}
 
-	if itemType != "Symbol" {
+	if itemType == "ResourceLink" {
+		resourceLinkItem, err := n.AsFieldItemResourceLink()
+		if err != nil {
+			panic(err)
+		}
+
+		if !compareAllowedResources(i.AllowedResources, &resourceLinkItem.AllowedResources) {
+			return false
+		}
+
+		if !compareValidations(i.Validations, resourceLinkItem.Validations) {
+			return false
+		}
+
+	} else if itemType != "Symbol" {
 		linkItem, err := n.AsFieldItemLink()
 		if err != nil {
 			panic(err)
@@ -838,10 +1105,30 @@ func (i *Items) Equal(n *sdk.FieldItem) bool {
 	return true
 }
 
+// orderedFields returns the fields sorted by their position. The map key is
+// copied into the Id of every field so the result can be sent to Contentful.
+func (c *ContentType) orderedFields() []Field {
+	fields := make([]Field, 0, len(c.Fields))
+
+	for id, field := range c.Fields {
+		field.Id = types.StringValue(id)
+		fields = append(fields, field)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		if fields[i].Position.ValueInt64() != fields[j].Position.ValueInt64() {
+			return fields[i].Position.ValueInt64() < fields[j].Position.ValueInt64()
+		}
+		return fields[i].Id.ValueString() < fields[j].Id.ValueString()
+	})
+
+	return fields
+}
+
 func (c *ContentType) Create() (*sdk.ContentTypeCreate, error) {
 	var fields []sdk.Field
 
-	for _, field := range c.Fields {
+	for _, field := range c.orderedFields() {
 
 		nativeField, err := field.ToNative()
 		if err != nil {
@@ -857,6 +1144,10 @@ func (c *ContentType) Create() (*sdk.ContentTypeCreate, error) {
 		Fields:       fields,
 	}
 
+	if c.Metadata != nil {
+		contentfulType.Metadata = c.Metadata.Draft(nil)
+	}
+
 	if !c.Description.IsNull() && !c.Description.IsUnknown() {
 		contentfulType.Description = c.Description.ValueStringPointer()
 	}
@@ -867,7 +1158,7 @@ func (c *ContentType) Create() (*sdk.ContentTypeCreate, error) {
 func (c *ContentType) Update() (*sdk.ContentTypeUpdate, error) {
 	var fields []sdk.Field
 
-	for _, field := range c.Fields {
+	for _, field := range c.orderedFields() {
 
 		nativeField, err := field.ToNative()
 		if err != nil {
@@ -883,6 +1174,10 @@ func (c *ContentType) Update() (*sdk.ContentTypeUpdate, error) {
 		Fields:       fields,
 	}
 
+	if c.Metadata != nil {
+		contentfulType.Metadata = c.Metadata.Draft(nil)
+	}
+
 	if !c.Description.IsNull() && !c.Description.IsUnknown() {
 		contentfulType.Description = c.Description.ValueStringPointer()
 	}
@@ -899,7 +1194,8 @@ func (c *ContentType) Import(n *sdk.ContentType) error {
 	c.Name = types.StringValue(n.Name)
 	c.DisplayField = types.StringPointerValue(n.DisplayField)
 
-	var fields []Field
+	positions := importPositions(c.Fields, n.Fields)
+	fields := map[string]Field{}
 
 	for _, nf := range n.Fields {
 		field := &Field{}
@@ -907,15 +1203,58 @@ func (c *ContentType) Import(n *sdk.ContentType) error {
 		if err != nil {
 			return fmt.Errorf("field import failed: %w", err)
 		}
-		fields = append(fields, *field)
+		field.Position = types.Int64Value(positions[nf.Id])
+		// The editor is part of the editor interface, see ImportEditorInterface
+		if previous, ok := c.Fields[nf.Id]; ok {
+			field.Editor = previous.Editor
+		}
+		fields[nf.Id] = *field
 	}
 
 	c.Fields = fields
 
+	// The metadata is only tracked when it is managed by terraform, metadata
+	// set in the web app is kept as is otherwise
+	if c.Metadata != nil {
+		c.Metadata.Import(n.Metadata)
+	}
+
 	return nil
 
 }
 
+// importPositions determines the position of every field returned by
+// Contentful. The positions that are already known (from state or plan) are
+// kept as long as they still describe the order of the remote fields, this
+// allows gaps like 10, 20, 30 in the configuration. When the order differs
+// the fields are renumbered by their index, which results in a plan that only
+// changes the positions.
+func importPositions(previous map[string]Field, remote []sdk.Field) map[string]int64 {
+	positions := map[string]int64{}
+
+	keep := true
+	last := int64(-1)
+	for _, nf := range remote {
+		field, ok := previous[nf.Id]
+		if !ok || field.Position.IsNull() || field.Position.IsUnknown() || field.Position.ValueInt64() <= last {
+			keep = false
+			break
+		}
+		last = field.Position.ValueInt64()
+		positions[nf.Id] = last
+	}
+
+	if keep {
+		return positions
+	}
+
+	for idx, nf := range remote {
+		positions[nf.Id] = int64(idx)
+	}
+
+	return positions
+}
+
 func (c *ContentType) Equal(n *sdk.ContentType) bool {
 
 	if !utils.CompareStringPointer(c.Description, n.Description) {
@@ -934,7 +1273,11 @@ func (c *ContentType) Equal(n *sdk.ContentType) bool {
 		return false
 	}
 
-	for idxOrg, field := range c.Fields {
+	if c.Metadata != nil && !c.Metadata.Equal(n.Metadata) {
+		return false
+	}
+
+	for idxOrg, field := range c.orderedFields() {
 		idx := pie.FindFirstUsing(n.Fields, func(f sdk.Field) bool {
 			return f.Id == field.Id.ValueString()
 		})
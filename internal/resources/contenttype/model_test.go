@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,3 +45,197 @@ func TestValidationDraftReturnsCorrectEnabledNodeTypesValidation(t *testing.T) {
 	assert.Equal(t, []string{}, *result.EnabledNodeTypes)
 	assert.Equal(t, "Unique validation message", *result.Message)
 }
+
+func TestContentTypeOrderedFieldsSortsByPosition(t *testing.T) {
+	contentType := ContentType{
+		Fields: map[string]Field{
+			"title":  {Position: types.Int64Value(20)},
+			"slug":   {Position: types.Int64Value(10)},
+			"body":   {Position: types.Int64Value(30)},
+			"author": {Position: types.Int64Value(5)},
+		},
+	}
+
+	var ids []string
+	for _, field := range contentType.orderedFields() {
+		ids = append(ids, field.Id.ValueString())
+	}
+
+	assert.Equal(t, []string{"author", "slug", "title", "body"}, ids)
+}
+
+func TestImportPositionsKeepsPreviousPositions(t *testing.T) {
+	previous := map[string]Field{
+		"slug":  {Position: types.Int64Value(10)},
+		"title": {Position: types.Int64Value(20)},
+	}
+
+	positions := importPositions(previous, []sdk.Field{{Id: "slug"}, {Id: "title"}})
+
+	assert.Equal(t, map[string]int64{"slug": 10, "title": 20}, positions)
+}
+
+func TestImportPositionsRenumbersWhenOrderChanged(t *testing.T) {
+	previous := map[string]Field{
+		"slug":  {Position: types.Int64Value(10)},
+		"title": {Position: types.Int64Value(20)},
+	}
+
+	positions := importPositions(previous, []sdk.Field{{Id: "title"}, {Id: "slug"}, {Id: "body"}})
+
+	assert.Equal(t, map[string]int64{"title": 0, "slug": 1, "body": 2}, positions)
+}
+
+func TestFieldToNativeWithResourceLink(t *testing.T) {
+	field := Field{
+		Id:   types.StringValue("reference"),
+		Name: types.StringValue("Reference"),
+		Type: types.StringValue("ResourceLink"),
+		AllowedResources: []AllowedResource{
+			{
+				Type:   types.StringValue("Contentful:Asset"),
+				Source: types.StringValue("crn:contentful:::content:spaces/abc123"),
+			},
+			{
+				Type:   types.StringValue("Shopify:Product"),
+				Source: types.StringNull(),
+			},
+		},
+	}
+
+	result, err := field.ToNative()
+
+	assert.NoError(t, err)
+	assert.Equal(t, &[]sdk.AllowedResource{
+		{Type: utils.Pointer("Contentful:Asset"), Source: utils.Pointer("crn:contentful:::content:spaces/abc123")},
+		{Type: utils.Pointer("Shopify:Product")},
+	}, result.AllowedResources)
+	assert.True(t, field.Equal(*result))
+
+	imported := Field{}
+	assert.NoError(t, imported.Import(*result))
+	assert.Equal(t, field.AllowedResources, imported.AllowedResources)
+}
+
+func TestItemsToNativeWithResourceLink(t *testing.T) {
+	items := Items{
+		Type: types.StringValue("ResourceLink"),
+		AllowedResources: []AllowedResource{
+			{
+				Type:   types.StringValue("Contentful:Entry"),
+				Source: types.StringValue("crn:contentful:::content:spaces/abc123"),
+				ContentTypes: []types.String{
+					types.StringValue("product"),
+				},
+			},
+		},
+	}
+
+	result, err := items.ToNative()
+
+	assert.NoError(t, err)
+
+	discriminator, err := result.Discriminator()
+	assert.NoError(t, err)
+	assert.Equal(t, "ResourceLink", discriminator)
+	assert.True(t, items.Equal(result))
+}
+
+func TestMetadataDraftAndImport(t *testing.T) {
+	metadata := Metadata{
+		Annotations: []types.String{types.StringValue("Contentful:AggregateRoot")},
+		FieldAnnotations: map[string][]types.String{
+			"sections": {types.StringValue("Contentful:AggregateComponent")},
+		},
+		Taxonomy: []TaxonomyValidation{
+			{
+				ConceptSchemeId: types.StringValue("scheme"),
+				ConceptId:       types.StringNull(),
+				Required:        types.BoolValue(true),
+			},
+			{
+				ConceptSchemeId: types.StringNull(),
+				ConceptId:       types.StringValue("concept"),
+				Required:        types.BoolValue(false),
+			},
+		},
+	}
+
+	draft := metadata.Draft(nil)
+
+	assert.Equal(t, "Contentful:AggregateRoot", (*draft.Annotations.ContentType)[0].Sys.Id)
+	assert.EqualValues(t, "Annotation", (*draft.Annotations.ContentType)[0].Sys.LinkType)
+	assert.Equal(t, "Contentful:AggregateComponent", (*draft.Annotations.ContentTypeField)["sections"][0].Sys.Id)
+	assert.EqualValues(t, "TaxonomyConceptScheme", (*draft.Taxonomy)[0].Sys.LinkType)
+	assert.EqualValues(t, "TaxonomyConcept", (*draft.Taxonomy)[1].Sys.LinkType)
+
+	imported := newMetadata(draft)
+
+	assert.Equal(t, &metadata, imported)
+	assert.True(t, metadata.Equal(draft))
+}
+
+func TestMetadataDraftKeepsUnmanagedAttributes(t *testing.T) {
+	remote := (&Metadata{
+		Annotations: []types.String{types.StringValue("Contentful:AggregateRoot")},
+		FieldAnnotations: map[string][]types.String{
+			"sections": {types.StringValue("Contentful:AggregateComponent")},
+		},
+		Taxonomy: []TaxonomyValidation{{
+			ConceptSchemeId: types.StringValue("scheme"),
+			ConceptId:       types.StringNull(),
+			Required:        types.BoolValue(false),
+		}},
+	}).Draft(nil)
+
+	metadata := Metadata{
+		Annotations: []types.String{},
+	}
+
+	draft := metadata.Draft(remote)
+
+	assert.Equal(t, []sdk.AnnotationLink{}, *draft.Annotations.ContentType)
+	assert.Equal(t, remote.Annotations.ContentTypeField, draft.Annotations.ContentTypeField)
+	assert.Equal(t, remote.Taxonomy, draft.Taxonomy)
+
+	// Only the configured annotations are compared and read back
+	assert.False(t, metadata.Equal(remote))
+
+	metadata.Import(remote)
+	assert.Equal(t, []types.String{types.StringValue("Contentful:AggregateRoot")}, metadata.Annotations)
+	assert.Nil(t, metadata.FieldAnnotations)
+	assert.Nil(t, metadata.Taxonomy)
+	assert.True(t, metadata.Equal(remote))
+}
+
+func TestMetadataImportKeepsOmittedAttributesNull(t *testing.T) {
+	metadata := Metadata{
+		Annotations: []types.String{types.StringValue("Contentful:AggregateRoot")},
+	}
+
+	metadata.Import(&sdk.ContentTypeMetadata{
+		Annotations: &sdk.ContentTypeAnnotations{
+			ContentType:      &[]sdk.AnnotationLink{},
+			ContentTypeField: &map[string][]sdk.AnnotationLink{},
+		},
+		Taxonomy: &[]sdk.TaxonomyValidation{},
+	})
+
+	assert.Equal(t, []types.String{}, metadata.Annotations)
+	assert.Nil(t, metadata.FieldAnnotations)
+	assert.Nil(t, metadata.Taxonomy)
+}
+
+func TestContentTypeImportIgnoresUnmanagedMetadata(t *testing.T) {
+	contentType := ContentType{}
+
+	err := contentType.Import(&sdk.ContentType{
+		Name: "Blog",
+		Metadata: &sdk.ContentTypeMetadata{
+			Taxonomy: &[]sdk.TaxonomyValidation{{}},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Nil(t, contentType.Metadata)
+}
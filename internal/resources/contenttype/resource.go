@@ -10,12 +10,16 @@ import (
 
 	"github.com/cenkalti/backoff/v5"
 	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -37,9 +41,10 @@ const (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &contentTypeResource{}
-	_ resource.ResourceWithConfigure   = &contentTypeResource{}
-	_ resource.ResourceWithImportState = &contentTypeResource{}
+	_ resource.Resource                 = &contentTypeResource{}
+	_ resource.ResourceWithConfigure    = &contentTypeResource{}
+	_ resource.ResourceWithImportState  = &contentTypeResource{}
+	_ resource.ResourceWithUpgradeState = &contentTypeResource{}
 )
 
 func NewContentTypeResource() resource.Resource {
@@ -48,14 +53,15 @@ func NewContentTypeResource() resource.Resource {
 
 // contentTypeResource is the resource implementation.
 type contentTypeResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
 }
 
 func (e *contentTypeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = request.ProviderTypeName + "_contenttype"
 }
 
-var resourceLinkTypes = []string{"Contentful:Entry"}
+var resourceLinkTypes = []string{"Contentful:Entry", "Contentful:Asset"}
 var arrayItemTypes = []string{"Symbol", "Link", "ResourceLink"}
 
 //https://www.contentful.com/developers/docs/extensibility/app-framework/editor-interfaces/
@@ -90,13 +96,51 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 			Attributes: map[string]schema.Attribute{
 				"type": schema.StringAttribute{
 					Optional: true,
+					MarkdownDescription: fmt.Sprintf("Type of the resource, either one of `%s` or an external resource type "+
+						"provided by an installed app, e.g. `Shopify:Product`.", strings.Join(resourceLinkTypes, "`, `")),
+					Validators: []validator.String{
+						customvalidator.ResourceLinkTypeValidator(resourceLinkTypes),
+						customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("source"), "Contentful:Entry"),
+						customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("source"), "Contentful:Asset"),
+					},
 				},
 				"source": schema.StringAttribute{
 					Optional: true,
+					MarkdownDescription: "CRN of the space the resources belong to, in the format " +
+						"`crn:contentful:::content:spaces/<space id>`. Use `provider::contentful::space_crn` to build it " +
+						"from a space id. Required for the Contentful resource types.",
+					Validators: []validator.String{
+						stringvalidator.RegexMatches(utils.SpaceCRNRegex, "must be a CRN in the format crn:contentful:::content:spaces/<space id>"),
+					},
 				},
 				"content_types": schema.ListAttribute{
-					Optional:    true,
-					ElementType: types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Content types of the entries that can be referenced, only used for `Contentful:Entry`.",
+					ElementType:         types.StringType,
+				},
+			},
+		},
+	}
+
+	editorWidgetSchema := schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"disabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"widget_id": schema.StringAttribute{
+				Required: true,
+			},
+			"widget_namespace": schema.StringAttribute{
+				Required: true,
+			},
+			"settings": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					custommodifier.StringDefault("{}"),
 				},
 			},
 		},
@@ -244,6 +288,7 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 	}
 
 	response.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "A content type consists of a set of fields and other information, read [this guide](https://www.contentful.com/developers/docs/concepts/data-model/) to learn more about modeling your content.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -280,16 +325,25 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 			"description": schema.StringAttribute{
 				Optional: true,
 			},
-			"fields": schema.ListNestedAttribute{
-				Validators: []validator.List{
-					listvalidator.SizeAtLeast(1),
-					listvalidator.UniqueValues(),
+			"adopt_existing": utils.AdoptExistingAttribute(),
+			"field": schema.MapNestedAttribute{
+				MarkdownDescription: "The fields of the content type, keyed by the field id. The order of the fields is " +
+					"determined by `position`.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					customvalidator.UniqueNestedAttributeValue("position"),
 				},
 				Required: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
+						"position": schema.Int64Attribute{
 							Required: true,
+							MarkdownDescription: "Position of the field in the content type. Positions only need to be " +
+								"unique, gaps (e.g. 10, 20, 30) are allowed so a field can be inserted without renumbering " +
+								"the other fields.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 						},
 						"name": schema.StringAttribute{
 							Required: true,
@@ -299,6 +353,7 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 							Validators: []validator.String{
 								stringvalidator.OneOf(utils.GetContentTypes()...),
 								customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("link_type"), "Link"),
+								customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("allowed_resources"), "ResourceLink"),
 								customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("items"), "Array"),
 							},
 						},
@@ -308,6 +363,7 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 								stringvalidator.OneOf(utils.GetLinkTypes()...),
 							},
 						},
+						"allowed_resources": allowedResourcesSchema,
 						"required": schema.BoolAttribute{
 							Optional: true,
 							Computed: true,
@@ -348,6 +404,7 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 									Validators: []validator.String{
 										stringvalidator.OneOf(arrayItemTypes...),
 										customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("link_type"), "Link"),
+										customvalidator.AttributeNeedsToBeSetValidator(path.MatchRelative().AtParent().AtName("allowed_resources"), "ResourceLink"),
 									},
 								},
 								"link_type": schema.StringAttribute{
@@ -356,7 +413,8 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 										stringvalidator.OneOf(utils.GetLinkTypes()...),
 									},
 								},
-								"validations": validationsSchema,
+								"allowed_resources": allowedResourcesSchema,
+								"validations":       validationsSchema,
 							},
 						},
 						"default_value": schema.SingleNestedAttribute{
@@ -378,12 +436,96 @@ func (e *contentTypeResource) Schema(ctx context.Context, request resource.Schem
 								customvalidator.DefaultValueStructure(),
 							},
 						},
+						"editor": schema.SingleNestedAttribute{
+							Optional: true,
+							MarkdownDescription: "The control of the field in the editor interface, it is written right after " +
+								"the content type is activated. Fields without an editor keep their current control.",
+							Attributes: map[string]schema.Attribute{
+								"widget_id": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: "The id of the widget, e.g. `singleLine` or the id of an app.",
+								},
+								"widget_namespace": schema.StringAttribute{
+									Optional:            true,
+									Computed:            true,
+									MarkdownDescription: "The namespace of the widget, e.g. `builtin`, `extension` or `app`. Defaults to `builtin`.",
+									PlanModifiers: []planmodifier.String{
+										custommodifier.StringDefault(string(sdk.EditorInterfaceControlWidgetNamespaceBuiltin)),
+									},
+								},
+								"settings": schema.StringAttribute{
+									CustomType:          jsontypes.NormalizedType{},
+									Optional:            true,
+									MarkdownDescription: "The settings of the widget as JSON, e.g. `jsonencode({ helpText = \"...\" })`.",
+								},
+							},
+						},
 					},
 					PlanModifiers: []planmodifier.Object{
 						custommodifier.FieldTypeChangeProhibited(),
 					},
 				},
 			},
+			"sidebar": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The sidebar of the editor interface. It is written together with the content type " +
+					"when set, do not combine it with a `contentful_editor_interface` for the same content type.",
+				NestedObject: editorWidgetSchema,
+			},
+			"editors": schema.ListNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "The entry editors of the editor interface. It is written together with the content " +
+					"type when set, do not combine it with a `contentful_editor_interface` for the same content type.",
+				NestedObject: editorWidgetSchema,
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 10),
+				},
+			},
+			"metadata": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "Annotations and taxonomy validations of the content type. Only the attributes " +
+					"which are set are managed, the other metadata is kept as set in the web app. Set an attribute to " +
+					"an empty value to remove its existing metadata.",
+				Attributes: map[string]schema.Attribute{
+					"annotations": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						MarkdownDescription: "Ids of the annotations of the content type, e.g. `Contentful:AggregateRoot`.",
+					},
+					"field_annotations": schema.MapAttribute{
+						Optional:            true,
+						ElementType:         types.ListType{ElemType: types.StringType},
+						MarkdownDescription: "Ids of the annotations per field, keyed by the field id, e.g. `Contentful:AggregateComponent`.",
+					},
+					"taxonomy": schema.ListNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Taxonomy concept schemes and concepts that can be assigned to entries of this content type.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"concept_scheme_id": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Id of the taxonomy concept scheme.",
+									Validators: []validator.String{
+										stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("concept_id")),
+									},
+								},
+								"concept_id": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Id of the taxonomy concept.",
+								},
+								"required": schema.BoolAttribute{
+									Optional:            true,
+									Computed:            true,
+									MarkdownDescription: "Whether entries need to be tagged with a concept of this validation.",
+									PlanModifiers: []planmodifier.Bool{
+										custommodifier.BoolDefault(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -395,7 +537,7 @@ func (e *contentTypeResource) Configure(_ context.Context, request resource.Conf
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
-
+	e.adoptExisting = data.AdoptExisting
 }
 
 func (e *contentTypeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -408,46 +550,47 @@ func (e *contentTypeResource) Create(ctx context.Context, request resource.Creat
 	spaceId := plan.SpaceId.ValueString()
 	environment := plan.Environment.ValueString()
 
-	var contentType *sdk.ContentType
-
+	// Without an explicit id the name is used as id of the content type
+	id := plan.Name.ValueString()
 	if !plan.ID.IsUnknown() && !plan.ID.IsNull() {
-		existingContentType, err := e.client.GetContentTypeWithResponse(ctx, spaceId, environment, plan.ID.ValueString())
-		if err != nil {
-			response.Diagnostics.AddError("Error creating contenttype", "Could not retrieve contenttype with id "+plan.ID.ValueString()+", unexpected error: "+err.Error())
-			return
-		}
+		id = plan.ID.ValueString()
+	}
 
-		if existingContentType.StatusCode() == http.StatusOK {
-			response.Diagnostics.AddError("Error creating contenttype", "Content type with id "+plan.ID.ValueString()+" already exists. Please import it and use the update resource to modify it, or remove before retrying.")
-			return
-		}
+	existingContentType, err := e.client.GetContentTypeWithResponse(ctx, spaceId, environment, id)
+	if err != nil {
+		response.Diagnostics.AddError("Error creating contenttype", "Could not retrieve contenttype with id "+id+", unexpected error: "+err.Error())
+		return
+	}
 
-		draft, err := plan.Update()
-		if err != nil {
-			response.Diagnostics.AddError("Error creating contenttype", err.Error())
-			return
-		}
-		resp, err := e.client.UpdateContentTypeWithResponse(ctx, spaceId, environment, plan.ID.ValueString(), nil, *draft)
-		if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
-			response.Diagnostics.AddError("Error creating contenttype", "Could not create contenttype with id "+plan.ID.ValueString()+", unexpected error: "+err.Error())
-			return
-		}
-		contentType = resp.JSON201
-	} else {
-		draft, err := plan.Update()
-		if err != nil {
-			response.Diagnostics.AddError("Error creating contenttype", err.Error())
+	if existingContentType.StatusCode() == http.StatusOK {
+		if !utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+			response.Diagnostics.AddError("Error creating contenttype", "Content type with id "+id+" already exists. Please import it, set adopt_existing to adopt it, or remove it before retrying.")
 			return
 		}
-		resp, err := e.client.UpdateContentTypeWithResponse(ctx, spaceId, environment, plan.Name.ValueString(), nil, *draft)
-		if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
-			response.Diagnostics.AddError("Error creating contenttype", "Could not create contenttype with name, unexpected error: "+err.Error())
+
+		plan.ID = types.StringValue(id)
+		if err := e.update(ctx, &plan, existingContentType.JSON200, true); err != nil {
+			response.Diagnostics.AddError("Error creating contenttype", "Could not adopt contenttype with id "+id+", unexpected error: "+err.Error())
 			return
 		}
-		contentType = resp.JSON201
+
+		utils.AddAdoptedWarning(&response.Diagnostics, "content type", id)
+		e.doRead(ctx, &plan, &response.State, &response.Diagnostics)
+		return
 	}
 
-	contentType, err := e.activateContentType(ctx, spaceId, environment, contentType.Sys.Id, contentType.Sys.Version)
+	draft, err := plan.Update()
+	if err != nil {
+		response.Diagnostics.AddError("Error creating contenttype", err.Error())
+		return
+	}
+	resp, err := e.client.UpdateContentTypeWithResponse(ctx, spaceId, environment, id, nil, *draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError("Error creating contenttype", "Could not create contenttype with id "+id+", unexpected error: "+err.Error())
+		return
+	}
+
+	contentType, err := e.activateContentType(ctx, spaceId, environment, resp.JSON201.Sys.Id, resp.JSON201.Sys.Version)
 	if err != nil {
 		response.Diagnostics.AddError("Error creating contenttype", "Could not activate contenttype, unexpected error: "+err.Error())
 		return
@@ -456,6 +599,15 @@ func (e *contentTypeResource) Create(ctx context.Context, request resource.Creat
 	plan.ID = types.StringValue(contentType.Sys.Id)
 	plan.Version = types.Int64Value(contentType.Sys.Version)
 
+	if plan.hasEditorInterface() {
+		editorInterface, err := e.updateEditorInterface(ctx, &plan)
+		if err != nil {
+			response.Diagnostics.AddError("Error creating contenttype", "Could not update editor interface, unexpected error: "+err.Error())
+			return
+		}
+		plan.ImportEditorInterface(editorInterface)
+	}
+
 	// Set state to fully populated data
 	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
 	if response.Diagnostics.HasError() {
@@ -491,6 +643,18 @@ func (e *contentTypeResource) Read(ctx context.Context, request resource.ReadReq
 
 	state.Import(resp.JSON200)
 
+	if state.hasEditorInterface() {
+		editorInterface, err := e.getEditorInterface(ctx, state)
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error reading contenttype",
+				"Could not retrieve editor interface, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		state.ImportEditorInterface(editorInterface)
+	}
+
 	// Set refreshed state
 	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
 }
@@ -515,6 +679,18 @@ func (e *contentTypeResource) doRead(ctx context.Context, contentType *ContentTy
 		return
 	}
 
+	if contentType.hasEditorInterface() {
+		editorInterface, err := e.getEditorInterface(ctx, contentType)
+		if err != nil {
+			d.AddError(
+				"Error reading contenttype",
+				"Could not retrieve editor interface, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		contentType.ImportEditorInterface(editorInterface)
+	}
+
 	// Set refreshed state
 	d.Append(state.Set(ctx, &contentType)...)
 	if d.HasError() {
@@ -547,68 +723,15 @@ func (e *contentTypeResource) Update(ctx context.Context, request resource.Updat
 		)
 		return
 	}
-	plan.Version = types.Int64Value(contentfulContentType.Sys.Version)
-
-	// Mark the fields as omitted that are no longer in the plan
-	deletedFields := pie.Of(pie.FilterNot(contentfulContentType.Fields, func(cf sdk.Field) bool {
-		return pie.FindFirstUsing(plan.Fields, func(f Field) bool {
-			return cf.Id == f.Id.ValueString()
-		}) != -1
-
-	})).Map(func(f sdk.Field) sdk.Field {
-		f.Omitted = utils.Pointer(true)
-
-		return f
-	}).Result
 
-	draft, err := plan.Update()
-
-	if err != nil {
-		response.Diagnostics.AddError("Error updating contenttype", err.Error())
+	if err := e.update(ctx, plan, contentfulContentType, !plan.editorInterfaceEqual(state)); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating contenttype",
+			"Could not update contenttype, unexpected error: "+err.Error(),
+		)
 		return
 	}
 
-	if len(deletedFields) > 0 {
-		draft.Fields = append(draft.Fields, deletedFields...)
-	}
-
-	// To remove a field from a content type 4 API calls need to be made.
-	// Omit the removed fields and publish the new version of the content type,
-	// followed by the field removal and final publish.
-
-	if !plan.Equal(contentfulContentType) {
-		contentType, err := e.doUpdate(ctx, plan, draft)
-		if err != nil {
-			response.Diagnostics.AddError(
-				"Error updating contenttype",
-				"Could not update contenttype, unexpected error: "+err.Error(),
-			)
-			return
-		}
-		plan.Version = types.Int64Value(contentType.Sys.Version)
-
-		// Now generate a new plan, to remove the fields that we previously marked
-		// as omitted
-		if len(deletedFields) > 0 {
-			draft, err = plan.Update()
-
-			if err != nil {
-				response.Diagnostics.AddError("Error updating contenttype", err.Error())
-				return
-			}
-
-			contentType, err = e.doUpdate(ctx, plan, draft)
-			if err != nil {
-				response.Diagnostics.AddError(
-					"Error updating contenttype",
-					"Could not update contenttype, unexpected error: "+err.Error(),
-				)
-				return
-			}
-			plan.Version = types.Int64Value(contentType.Sys.Version)
-		}
-	}
-
 	e.doRead(ctx, plan, &response.State, &response.Diagnostics)
 }
 
@@ -703,6 +826,7 @@ func (e *contentTypeResource) ImportState(ctx context.Context, request resource.
 	}
 	state.SpaceId = types.StringValue(spaceId)
 	state.Environment = types.StringValue(environment)
+	state.Metadata = newMetadata(resp.JSON200.Metadata)
 
 	// Set refreshed state
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
@@ -742,6 +866,120 @@ func (e *contentTypeResource) doUpdate(ctx context.Context, plan *ContentType, d
 	return e.activateContentType(ctx, spaceId, environment, id, contentType.Sys.Version)
 }
 
+// update brings the given content type in Contentful in line with the plan.
+// Fields which are no longer in the plan are removed from the content type.
+func (e *contentTypeResource) update(ctx context.Context, plan *ContentType, contentfulContentType *sdk.ContentType, editorInterfaceChanged bool) error {
+	plan.Version = types.Int64Value(contentfulContentType.Sys.Version)
+
+	// Mark the fields as omitted that are no longer in the plan
+	deletedFields := pie.Of(pie.FilterNot(contentfulContentType.Fields, func(cf sdk.Field) bool {
+		_, ok := plan.Fields[cf.Id]
+		return ok
+	})).Map(func(f sdk.Field) sdk.Field {
+		f.Omitted = utils.Pointer(true)
+
+		return f
+	}).Result
+
+	draft, err := plan.Update()
+	if err != nil {
+		return err
+	}
+
+	if len(deletedFields) > 0 {
+		draft.Fields = append(draft.Fields, deletedFields...)
+	}
+
+	// Keep the metadata that is not managed by terraform, otherwise it would
+	// be removed by the update
+	draft.Metadata = plan.Metadata.Draft(contentfulContentType.Metadata)
+
+	// To remove a field from a content type 4 API calls need to be made.
+	// Omit the removed fields and publish the new version of the content type,
+	// followed by the field removal and final publish.
+
+	contentTypeChanged := !plan.Equal(contentfulContentType)
+
+	if contentTypeChanged {
+		contentType, err := e.doUpdate(ctx, plan, draft)
+		if err != nil {
+			return err
+		}
+		plan.Version = types.Int64Value(contentType.Sys.Version)
+
+		// Now generate a new plan, to remove the fields that we previously marked
+		// as omitted
+		if len(deletedFields) > 0 {
+			draft, err = plan.Update()
+			if err != nil {
+				return err
+			}
+
+			draft.Metadata = plan.Metadata.Draft(contentfulContentType.Metadata)
+
+			contentType, err = e.doUpdate(ctx, plan, draft)
+			if err != nil {
+				return err
+			}
+			plan.Version = types.Int64Value(contentType.Sys.Version)
+		}
+	}
+
+	// The activation of the content type updates the editor interface, so it
+	// is written afterward
+	if plan.hasEditorInterface() && (contentTypeChanged || editorInterfaceChanged) {
+		if _, err := e.updateEditorInterface(ctx, plan); err != nil {
+			return fmt.Errorf("could not update editor interface: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (e *contentTypeResource) getEditorInterface(ctx context.Context, contentType *ContentType) (*sdk.EditorInterface, error) {
+	resp, err := e.client.GetEditorInterfaceWithResponse(ctx, contentType.SpaceId.ValueString(), contentType.Environment.ValueString(), contentType.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// updateEditorInterface writes the editor interface configured on the content
+// type. Contentful updates the editor interface when the content type is
+// activated, so the update is retried with the latest version on a conflict.
+func (e *contentTypeResource) updateEditorInterface(ctx context.Context, contentType *ContentType) (*sdk.EditorInterface, error) {
+	spaceId := contentType.SpaceId.ValueString()
+	environment := contentType.Environment.ValueString()
+	id := contentType.ID.ValueString()
+
+	return backoff.Retry(ctx, func() (*sdk.EditorInterface, error) {
+		current, err := e.client.GetEditorInterfaceWithResponse(ctx, spaceId, environment, id)
+		if err := utils.CheckClientResponse(current, err, http.StatusOK); err != nil {
+			// The editor interface is created when the content type is
+			// activated, it might not be available yet
+			if current != nil && current.StatusCode() == http.StatusNotFound {
+				return nil, err
+			}
+			return nil, backoff.Permanent(err)
+		}
+
+		params := &sdk.UpdateEditorInterfaceParams{
+			XContentfulVersion: current.JSON200.Sys.Version,
+		}
+
+		resp, err := e.client.UpdateEditorInterfaceWithResponse(ctx, spaceId, environment, id, params, contentType.EditorInterfaceDraft(current.JSON200))
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusConflict {
+				return nil, err
+			}
+			return nil, backoff.Permanent(err)
+		}
+
+		return resp.JSON200, nil
+	}, backoff.WithMaxTries(5), backoff.WithMaxElapsedTime(60*time.Second), backoff.WithBackOff(backoff.NewExponentialBackOff()))
+}
+
 func (e *contentTypeResource) getContentType(ctx context.Context, editor *ContentType) (*sdk.ContentType, error) {
 	spaceId := editor.SpaceId.ValueString()
 	environment := editor.Environment.ValueString()
@@ -273,7 +273,160 @@ func TestContentTypeResource_WithDuplicateField(t *testing.T) {
 			{
 				Config:      testContentTypeDuplicateFields("acctest_content_type", os.Getenv("CONTENTFUL_SPACE_ID")),
 				PlanOnly:    true,
-				ExpectError: regexp.MustCompile("Error: Duplicate List Value"),
+				ExpectError: regexp.MustCompile("Error: Duplicate Attribute Value"),
+			},
+		},
+	})
+}
+
+func TestContentTypeResource_WithResourceLink(t *testing.T) {
+	resourceName := "contentful_contenttype.acctest_content_type"
+	spaceId := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", false)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testContentTypeResourceLink("acctest_content_type", spaceId, fmt.Sprintf("provider::contentful::space_crn(%q)", spaceId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "field.entry_reference.allowed_resources.0.source", "crn:contentful:::content:spaces/"+spaceId),
+					testAccCheckContentfulContentTypeExists(t, resourceName, func(t *testing.T, contentType *sdk.ContentType) {
+						assert.Len(t, contentType.Fields, 3)
+						assert.EqualValues(t, "ResourceLink", contentType.Fields[1].Type)
+						assert.Equal(t, &[]sdk.AllowedResource{{
+							Type:   utils.Pointer("Contentful:Entry"),
+							Source: utils.Pointer("crn:contentful:::content:spaces/" + spaceId),
+						}}, contentType.Fields[1].AllowedResources)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestContentTypeResource_WithInvalidResourceLinkSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", false)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testContentTypeResourceLink("acctest_content_type", os.Getenv("CONTENTFUL_SPACE_ID"), `"spaces/invalid"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must be a CRN in the format"),
+			},
+		},
+	})
+}
+
+func TestContentTypeResource_WithMetadata(t *testing.T) {
+	resourceName := "contentful_contenttype.acctest_content_type"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", false)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testContentTypeMetadata("acctest_content_type", os.Getenv("CONTENTFUL_SPACE_ID")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.annotations.0", "Contentful:AggregateRoot"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.field_annotations.sections.0", "Contentful:AggregateComponent"),
+					testAccCheckContentfulContentTypeExists(t, resourceName, func(t *testing.T, contentType *sdk.ContentType) {
+						assert.NotNil(t, contentType.Metadata)
+						assert.Len(t, *contentType.Metadata.Annotations.ContentType, 1)
+						assert.Equal(t, "Contentful:AggregateRoot", (*contentType.Metadata.Annotations.ContentType)[0].Sys.Id)
+						assert.Len(t, (*contentType.Metadata.Annotations.ContentTypeField)["sections"], 1)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestContentTypeResource_WithEditor(t *testing.T) {
+	resourceName := "contentful_contenttype.acctest_content_type"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", false)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testContentTypeEditor("acctest_content_type", os.Getenv("CONTENTFUL_SPACE_ID"), "The title"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "field.title.editor.widget_id", "singleLine"),
+					resource.TestCheckResourceAttr(resourceName, "field.title.editor.widget_namespace", "builtin"),
+					testAccCheckEditorInterfaceExists(t, "tf_editor", func(t *testing.T, editorInterface *sdk.EditorInterface) {
+						assert.Len(t, editorInterface.Controls, 2)
+						assert.Equal(t, "singleLine", *editorInterface.Controls[0].WidgetId)
+						assert.Equal(t, "The title", *editorInterface.Controls[0].Settings.HelpText)
+						assert.Equal(t, "slugEditor", *editorInterface.Controls[1].WidgetId)
+						assert.Equal(t, "publication-widget", *(*editorInterface.Sidebar)[0].WidgetId)
+					}),
+				),
+			},
+			{
+				Config: testContentTypeEditor("acctest_content_type", os.Getenv("CONTENTFUL_SPACE_ID"), "The new title"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEditorInterfaceExists(t, "tf_editor", func(t *testing.T, editorInterface *sdk.EditorInterface) {
+						assert.Equal(t, "The new title", *editorInterface.Controls[0].Settings.HelpText)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestContentTypeResource_AdoptExisting(t *testing.T) {
+	resourceName := "contentful_contenttype.acctest_content_type"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulContentTypeDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", false)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					resp, err := client.UpdateContentTypeWithResponse(context.Background(), spaceID, "master", "tf_adopt", nil, sdk.ContentTypeUpdate{
+						Name:         "tf_adopt",
+						DisplayField: utils.Pointer("title"),
+						Fields: []sdk.Field{
+							{Id: "title", Name: "Existing title", Type: sdk.FieldTypeSymbol},
+						},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating content type: %d", resp.StatusCode())
+					}
+				},
+				Config: testContentTypeAdopt("acctest_content_type", spaceID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tf_adopt"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulContentTypeExists(t, resourceName, func(t *testing.T, contentType *sdk.ContentType) {
+						assert.Equal(t, "Terraform Acc Test adopted Content Type", *contentType.Description)
+						assert.Len(t, contentType.Fields, 1)
+						assert.Equal(t, "Title", contentType.Fields[0].Name)
+						assert.True(t, contentType.Fields[0].Required)
+					}),
+				),
 			},
 		},
 	})
@@ -415,3 +568,33 @@ func testContentTypeLinkConfig(identifier string, spaceId string, linkIdentifier
 		"spaceId":        spaceId,
 	})
 }
+
+func testContentTypeResourceLink(identifier string, spaceId string, source string) string {
+	return utils.HCLTemplateFromPath("test_resources/resource_link.tf", map[string]any{
+		"identifier": identifier,
+		"spaceId":    spaceId,
+		"source":     source,
+	})
+}
+
+func testContentTypeMetadata(identifier string, spaceId string) string {
+	return utils.HCLTemplateFromPath("test_resources/metadata.tf", map[string]any{
+		"identifier": identifier,
+		"spaceId":    spaceId,
+	})
+}
+
+func testContentTypeEditor(identifier string, spaceId string, helpText string) string {
+	return utils.HCLTemplateFromPath("test_resources/editor.tf", map[string]any{
+		"identifier": identifier,
+		"spaceId":    spaceId,
+		"helpText":   helpText,
+	})
+}
+
+func testContentTypeAdopt(identifier string, spaceId string) string {
+	return utils.HCLTemplateFromPath("test_resources/adopt.tf", map[string]any{
+		"identifier": identifier,
+		"spaceId":    spaceId,
+	})
+}
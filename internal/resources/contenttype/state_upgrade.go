@@ -0,0 +1,71 @@
+package contenttype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func (e *contentTypeResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored the fields as a list, version 1 stores them in a
+		// map keyed by the field id with an explicit position.
+		0: {
+			StateUpgrader: func(_ context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+				if request.RawState == nil {
+					response.Diagnostics.AddError("Error upgrading content type state", "No prior state available to upgrade")
+					return
+				}
+
+				upgraded, err := upgradeFieldsV0(request.RawState.JSON)
+				if err != nil {
+					response.Diagnostics.AddError("Error upgrading content type state", "Could not upgrade content type state: "+err.Error())
+					return
+				}
+
+				response.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeFieldsV0 converts the raw json state of version 0, which contains a
+// `fields` list, to the `field` map of version 1. The index in the list
+// becomes the position of the field.
+func upgradeFieldsV0(raw []byte) ([]byte, error) {
+	state := map[string]any{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+
+	fields, _ := state["fields"].([]any)
+	fieldMap := make(map[string]any, len(fields))
+
+	for i, item := range fields {
+		field, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected value for field at index %d", i)
+		}
+
+		id, ok := field["id"].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("field at index %d has no id", i)
+		}
+
+		if _, exists := fieldMap[id]; exists {
+			return nil, fmt.Errorf("field %q is defined more than once", id)
+		}
+
+		delete(field, "id")
+		field["position"] = i
+		fieldMap[id] = field
+	}
+
+	delete(state, "fields")
+	state["field"] = fieldMap
+
+	return json.Marshal(state)
+}
@@ -0,0 +1,32 @@
+package contenttype
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradeFieldsV0(t *testing.T) {
+	raw := []byte(`{"id":"blog","name":"Blog","fields":[{"id":"title","name":"Title","type":"Symbol"},{"id":"body","name":"Body","type":"Text"}]}`)
+
+	upgraded, err := upgradeFieldsV0(raw)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"id":"blog",
+		"name":"Blog",
+		"field":{
+			"title":{"name":"Title","type":"Symbol","position":0},
+			"body":{"name":"Body","type":"Text","position":1}
+		}
+	}`, string(upgraded))
+}
+
+func TestUpgradeFieldsV0ReturnsErrorForDuplicateField(t *testing.T) {
+	raw := []byte(`{"fields":[{"id":"title"},{"id":"title"}]}`)
+
+	_, err := upgradeFieldsV0(raw)
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `field "title" is defined more than once`)
+}
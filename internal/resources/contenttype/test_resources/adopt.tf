@@ -0,0 +1,17 @@
+resource "contentful_contenttype" "{{ .identifier }}" {
+  space_id       = "{{ .spaceId }}"
+  environment    = "master"
+  id             = "tf_adopt"
+  name           = "tf_adopt"
+  description    = "Terraform Acc Test adopted Content Type"
+  display_field  = "title"
+  adopt_existing = true
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      required = true
+      type     = "Symbol"
+    }
+  }
+}
@@ -5,29 +5,33 @@ resource "contentful_contenttype" "{{ .identifier }}" {
   name          = "tf_test1"
   description   = "{{.desc}}"
   display_field = "field1"
-  fields = [{
-    id       = "field1"
-    name     = "Field 1 name change"
-    required = true
-    type     = "Text"
-    }, {
-    id       = "field3"
-    name     = "Field 3 new field"
-    required = true
-    type     = "Integer"
-  }, {
-    id          = "field4"
-    name        = "Field 4 new field"
-    required    = true
-    type        = "RichText"
-    validations = [
-      {
-        enabled_marks = ["bold"]
-        message       = "Supports only bold."
-      },
-      {
-        enabled_node_types = ["embedded-asset-block"]
-      }
-    ]
-  }]
+  field = {
+    field1 = {
+      position = 0
+      name     = "Field 1 name change"
+      required = true
+      type     = "Text"
+    }
+    field3 = {
+      position = 1
+      name     = "Field 3 new field"
+      required = true
+      type     = "Integer"
+    }
+    field4 = {
+      position = 2
+      name     = "Field 4 new field"
+      required = true
+      type     = "RichText"
+      validations = [
+        {
+          enabled_marks = ["bold"]
+          message       = "Supports only bold."
+        },
+        {
+          enabled_node_types = ["embedded-asset-block"]
+        }
+      ]
+    }
+  }
 }
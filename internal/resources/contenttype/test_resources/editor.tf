@@ -0,0 +1,39 @@
+resource "contentful_contenttype" "{{ .identifier }}" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  id            = "tf_editor"
+  name          = "tf_editor"
+  description   = "Terraform Acc Test Content Type with editor controls"
+  display_field = "title"
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      required = true
+      type     = "Symbol"
+      editor = {
+        widget_id = "singleLine"
+        settings = jsonencode({
+          helpText = "{{ .helpText }}"
+        })
+      }
+    }
+    slug = {
+      position = 1
+      name     = "Slug"
+      type     = "Symbol"
+      editor = {
+        widget_id = "slugEditor"
+        settings = jsonencode({
+          trackingFieldId = "title"
+        })
+      }
+    }
+  }
+  sidebar = [
+    {
+      widget_id        = "publication-widget"
+      widget_namespace = "sidebar-builtin"
+    }
+  ]
+}
@@ -4,20 +4,20 @@ resource "contentful_contenttype" "{{ .identifier }}" {
   name = "tf_test1"
   description = "Terraform Acc Test Content Type description change"
   display_field = "field1"
-  fields = [
-    {
-      id        = "field1"
-      name      = "Field 1 name change"
-      required  = true
-      type      = "Text"
-    },
-    {
-      id        = "field3"
-      name      = "Field 3 new field"
-      required  = true
-      type      = "Integer"
+  field = {
+    field1 = {
+      position = 0
+      name     = "Field 1 name change"
+      required = true
+      type     = "Text"
+    }
+    field3 = {
+      position = 1
+      name     = "Field 3 new field"
+      required = true
+      type     = "Integer"
     }
-  ]
+  }
 }
 
 resource "contentful_contenttype" "{{ .linkIdentifier }}" {
@@ -26,19 +26,19 @@ resource "contentful_contenttype" "{{ .linkIdentifier }}" {
   environment   = "master"
   description   = "Terraform Acc Test Content Type with links"
   display_field = "asset_field"
-  fields = [
-    {
-      id   = "asset_field"
-      name = "Asset Field"
-      type = "Array"
+  field = {
+    asset_field = {
+      position = 0
+      name     = "Asset Field"
+      type     = "Array"
       items = {
         type      = "Link"
         link_type = "Asset"
       }
       required = true
-    },
-    {
-      id        = "entry_link_field"
+    }
+    entry_link_field = {
+      position  = 1
       name      = "Entry Link Field"
       type      = "Link"
       link_type = "Entry"
@@ -48,5 +48,5 @@ resource "contentful_contenttype" "{{ .linkIdentifier }}" {
         }
       ]
     }
-  ]
+  }
 }
@@ -0,0 +1,30 @@
+resource "contentful_contenttype" "{{ .identifier }}" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  name          = "tf_metadata"
+  description   = "Terraform Acc Test Content Type with metadata"
+  display_field = "title"
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      required = true
+      type     = "Symbol"
+    }
+    sections = {
+      position = 1
+      name     = "Sections"
+      type     = "Array"
+      items = {
+        type      = "Link"
+        link_type = "Entry"
+      }
+    }
+  }
+  metadata = {
+    annotations = ["Contentful:AggregateRoot"]
+    field_annotations = {
+      sections = ["Contentful:AggregateComponent"]
+    }
+  }
+}
@@ -0,0 +1,40 @@
+resource "contentful_contenttype" "{{ .identifier }}" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  name          = "tf_resource_link"
+  description   = "Terraform Acc Test Content Type with resource links"
+  display_field = "title"
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      required = true
+      type     = "Symbol"
+    }
+    entry_reference = {
+      position = 1
+      name     = "Entry Reference"
+      type     = "ResourceLink"
+      allowed_resources = [
+        {
+          type   = "Contentful:Entry"
+          source = {{ .source }}
+        }
+      ]
+    }
+    asset_references = {
+      position = 2
+      name     = "Asset References"
+      type     = "Array"
+      items = {
+        type = "ResourceLink"
+        allowed_resources = [
+          {
+            type   = "Contentful:Asset"
+            source = {{ .source }}
+          }
+        ]
+      }
+    }
+  }
+}
@@ -4,24 +4,18 @@ resource "contentful_contenttype" "{{ .identifier }}" {
   name          = "tf_test1"
   description   = "Terraform Acc Test Content Type description change"
   display_field = "field1"
-  fields = [
-    {
-      id       = "field1"
+  field = {
+    field1 = {
+      position = 0
       name     = "Field 1 name change"
       required = true
       type     = "Text"
-    },
-    {
-      id       = "field1"
-      name     = "Field 1 name change"
-      required = true
-      type     = "Text"
-    },
-    {
-      id       = "field3"
+    }
+    field3 = {
+      position = 0
       name     = "Field 3 new field"
       required = true
       type     = "Integer"
     }
-  ]
+  }
 }
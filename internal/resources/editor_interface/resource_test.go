@@ -196,20 +196,20 @@ resource "contentful_contenttype" "test_contenttype" {
   description  = "Test Content Type for Editor Interface"
   display_field = "title"
 
-	fields  = [
-		{
-			id        = "title"
-			name      = "Title"
-			type      = "Symbol"
-			required  = true
-		},
-		{
-			id        = "description"
-			name      = "Description"
-			type      = "Text"
-			required  = false
+	field = {
+		title = {
+			position = 0
+			name     = "Title"
+			type     = "Symbol"
+			required = true
+		}
+		description = {
+			position = 1
+			name     = "Description"
+			type     = "Text"
+			required = false
 		}
-	]
+	}
 }
 
 resource "contentful_editor_interface" "test_editor_interface" {
@@ -245,20 +245,20 @@ resource "contentful_contenttype" "test_contenttype" {
   description  = "Test Content Type for Editor Interface"
   display_field = "title"
 
-	fields = [
-		{
-			id        = "title"
-			name      = "Title"
-			type      = "Symbol"
-			required  = true
-		},
-		{
-			id        = "description"
-			name      = "Description"
-			type      = "Text"
-			required  = false
+	field = {
+		title = {
+			position = 0
+			name     = "Title"
+			type     = "Symbol"
+			required = true
+		}
+		description = {
+			position = 1
+			name     = "Description"
+			type     = "Text"
+			required = false
 		}
-	]
+	}
 }
 
 resource "contentful_editor_interface" "test_editor_interface" {
@@ -313,20 +313,20 @@ resource "contentful_contenttype" "test_contenttype" {
   description  = "Test Content Type for Editor Interface"
   display_field = "title"
 
-	fields = [
-		{
-			id        = "title"
-			name      = "Title"
-			type      = "Symbol"
-			required  = true
-		},
-		{
-			id        = "description"
-			name      = "Description"
-			type      = "Text"
-			required  = false
+	field = {
+		title = {
+			position = 0
+			name     = "Title"
+			type     = "Symbol"
+			required = true
+		}
+		description = {
+			position = 1
+			name     = "Description"
+			type     = "Text"
+			required = false
 		}
-	]
+	}
 }
 
 resource "contentful_editor_interface" "test_editor_interface" {
@@ -372,13 +372,13 @@ resource "contentful_contenttype" "test_contenttype_datepicker" {
   description  = "Test Content Type for Editor Interface with Date Picker"
   display_field = "date"
 
-	fields  = [
-		{
-			id        = "date"
-			name      = "Date"
-			type      = "Date"
+	field = {
+		date = {
+			position = 0
+			name     = "Date"
+			type     = "Date"
 		}
-	]
+	}
 }
 
 resource "contentful_editor_interface" "test_editor_interface_datepicker" {
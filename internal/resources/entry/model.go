@@ -3,7 +3,10 @@ package entry
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/iancoleman/orderedmap"
 
@@ -13,15 +16,23 @@ import (
 
 // Entry is the main resource schema data
 type Entry struct {
-	ID            types.String `tfsdk:"id"`
-	EntryID       types.String `tfsdk:"entry_id"`
-	Version       types.Int64  `tfsdk:"version"`
-	SpaceID       types.String `tfsdk:"space_id"`
-	Environment   types.String `tfsdk:"environment"`
-	ContentTypeID types.String `tfsdk:"contenttype_id"`
-	Field         []Field      `tfsdk:"field"`
-	Published     types.Bool   `tfsdk:"published"`
-	Archived      types.Bool   `tfsdk:"archived"`
+	ID               types.String `tfsdk:"id"`
+	EntryID          types.String `tfsdk:"entry_id"`
+	Version          types.Int64  `tfsdk:"version"`
+	SpaceID          types.String `tfsdk:"space_id"`
+	Environment      types.String `tfsdk:"environment"`
+	ContentTypeID    types.String `tfsdk:"contenttype_id"`
+	Field            []Field      `tfsdk:"field"`
+	Published        types.Bool   `tfsdk:"published"`
+	Archived         types.Bool   `tfsdk:"archived"`
+	PublishedLocales types.Set    `tfsdk:"published_locales"`
+	Tags             types.Set    `tfsdk:"tags"`
+	TagsAll          types.Set    `tfsdk:"tags_all"`
+	PublishedVersion types.Int64  `tfsdk:"published_version"`
+	Status           types.String `tfsdk:"status"`
+	ManagedFields    types.Bool   `tfsdk:"managed_fields"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	AdoptExisting    types.Bool   `tfsdk:"adopt_existing"`
 }
 
 // Field represents a content field in an Entry
@@ -41,10 +52,92 @@ func (e *Entry) Import(entry *sdk.Entry) {
 	e.ContentTypeID = types.StringValue(entry.Sys.ContentType.Sys.Id)
 	e.Published = types.BoolValue(entry.Sys.PublishedAt != nil)
 	e.Archived = types.BoolValue(entry.Sys.ArchivedAt != nil)
+	e.PublishedVersion = types.Int64PointerValue(entry.Sys.PublishedVersion)
+	e.Status = types.StringValue(utils.PublishStatus(entry.Sys.Version, entry.Sys.PublishedVersion, entry.Sys.PublishedAt != nil, entry.Sys.ArchivedAt != nil))
+
+	// The published locales are only read when they are configured, otherwise
+	// the whole entry is published. The configured published locales are kept
+	// when Contentful doesn't report the status per locale.
+	if e.PublishedLocales.IsNull() || e.PublishedLocales.IsUnknown() {
+		e.PublishedLocales = types.SetNull(types.StringType)
+	} else if locales := publishedLocales(entry); locales != nil {
+		values := make([]attr.Value, 0, len(locales))
+		for _, locale := range locales {
+			values = append(values, types.StringValue(locale))
+		}
+		e.PublishedLocales = types.SetValueMust(types.StringType, values)
+	}
+
+	e.Tags, e.TagsAll = utils.ImportTags(entry.Metadata, e.Tags, e.TagsAll)
 
 	e.BuildFieldsFromAPIResponse(entry)
 }
 
+// publishedLocales returns the locales of the entry which are published,
+// including the ones with changes since they were published. Returns nil when
+// Contentful doesn't report the status per locale.
+func publishedLocales(entry *sdk.Entry) []string {
+	if entry.Sys.FieldStatus == nil {
+		return nil
+	}
+
+	status, ok := (*entry.Sys.FieldStatus)["*"]
+	if !ok {
+		return nil
+	}
+
+	result := []string{}
+	for locale, value := range status {
+		if value == utils.StatusPublished || value == utils.StatusChanged {
+			result = append(result, locale)
+		}
+	}
+	slices.Sort(result)
+
+	return result
+}
+
+// PublishLocalesDraft returns the locales to publish and unpublish to get
+// from the locales published in current to the configured published locales.
+// When republish is set all configured locales are published again. Returns
+// nil when nothing needs to change.
+func (e *Entry) PublishLocalesDraft(current *Entry, republish bool) *sdk.EntryPublishLocales {
+	configured := utils.SetStrings(e.PublishedLocales)
+
+	// An entry which is not published has no published locales, also when
+	// Contentful doesn't report the status per locale
+	var published []string
+	if current.Published.ValueBool() {
+		published = utils.SetStrings(current.PublishedLocales)
+	}
+
+	var add, remove []string
+	for _, locale := range configured {
+		if republish || !slices.Contains(published, locale) {
+			add = append(add, locale)
+		}
+	}
+	for _, locale := range published {
+		if !slices.Contains(configured, locale) {
+			remove = append(remove, locale)
+		}
+	}
+
+	if len(add) == 0 && len(remove) == 0 {
+		return nil
+	}
+
+	result := &sdk.EntryPublishLocales{}
+	if len(add) > 0 {
+		result.Add = &sdk.EntryLocaleSelection{Fields: &map[string][]string{"*": add}}
+	}
+	if len(remove) > 0 {
+		result.Remove = &sdk.EntryLocaleSelection{Fields: &map[string][]string{"*": remove}}
+	}
+
+	return result
+}
+
 // DraftForCreate creates an EntryCreate object for creating a new entry
 func (e *Entry) Draft() sdk.EntryDraft {
 	fieldProperties := orderedmap.New()
@@ -64,7 +157,8 @@ func (e *Entry) Draft() sdk.EntryDraft {
 	}
 
 	return sdk.EntryDraft{
-		Fields: fieldProperties,
+		Fields:   fieldProperties,
+		Metadata: utils.TagsMetadata(e.TagsAll),
 	}
 }
 
@@ -79,8 +173,135 @@ func ParseContentValue(value string) interface{} {
 	return utils.SortOrderedMapRecursively(content)
 }
 
-// BuildFieldsFromAPIResponse builds the Field array from API response
+// fieldKey identifies the value of a field in a single locale
+type fieldKey struct {
+	id     string
+	locale string
+}
+
+func fieldKeys(fields []Field) map[fieldKey]bool {
+	result := make(map[fieldKey]bool, len(fields))
+	for _, field := range fields {
+		result[fieldKey{id: field.ID.ValueString(), locale: field.Locale.ValueString()}] = true
+	}
+	return result
+}
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func fieldPath(segments ...string) string {
+	result := "/fields"
+	for _, segment := range segments {
+		result += "/" + jsonPointerEscaper.Replace(segment)
+	}
+	return result
+}
+
+// Patch builds the JSON patch which writes the configured fields and tags to
+// the current entry. Fields which were managed before but are no longer
+// configured are removed, all other fields of the entry are left untouched.
+func (e *Entry) Patch(current *sdk.Entry, previous []Field) sdk.JsonPatch {
+	patch := sdk.JsonPatch{}
+
+	currentLocales := func(id string) (orderedmap.OrderedMap, bool) {
+		value, ok := current.Fields.Get(id)
+		if !ok {
+			return orderedmap.OrderedMap{}, false
+		}
+		locales, ok := value.(orderedmap.OrderedMap)
+		return locales, ok
+	}
+
+	// Group the values by field, a field which does not exist yet has to be
+	// added with all its locales at once
+	draft := e.Draft()
+	for _, id := range draft.Fields.Keys() {
+		value, _ := draft.Fields.Get(id)
+		values := value.(map[string]any)
+
+		if _, ok := currentLocales(id); !ok {
+			var fieldValue any = values
+			patch = append(patch, sdk.JsonPatchOperation{
+				Op:    sdk.JsonPatchOperationOp("add"),
+				Path:  fieldPath(id),
+				Value: &fieldValue,
+			})
+			continue
+		}
+
+		for _, field := range e.Field {
+			if field.ID.ValueString() != id {
+				continue
+			}
+
+			locale := field.Locale.ValueString()
+			localeValue := values[locale]
+			patch = append(patch, sdk.JsonPatchOperation{
+				Op:    sdk.JsonPatchOperationOp("add"),
+				Path:  fieldPath(id, locale),
+				Value: &localeValue,
+			})
+		}
+	}
+
+	configured := fieldKeys(e.Field)
+	for _, field := range previous {
+		key := fieldKey{id: field.ID.ValueString(), locale: field.Locale.ValueString()}
+		if configured[key] {
+			continue
+		}
+
+		locales, ok := currentLocales(key.id)
+		if !ok {
+			continue
+		}
+
+		if _, ok := locales.Get(key.locale); !ok {
+			continue
+		}
+
+		patch = append(patch, sdk.JsonPatchOperation{
+			Op:   sdk.JsonPatchOperationOp("remove"),
+			Path: fieldPath(key.id, key.locale),
+		})
+	}
+
+	if metadata := draft.Metadata; metadata != nil && !slices.Equal(utils.SetStrings(e.TagsAll), utils.SetStrings(utils.TagsFromMetadata(current.Metadata))) {
+		var value any = metadata.Tags
+		operation := sdk.JsonPatchOperation{
+			Op:    sdk.JsonPatchOperationOp("add"),
+			Path:  "/metadata/tags",
+			Value: &value,
+		}
+		if current.Metadata == nil {
+			value = metadata
+			operation.Path = "/metadata"
+		}
+		patch = append(patch, operation)
+	}
+
+	return patch
+}
+
+// PatchedFields returns the fields which were written with a patch and can be
+// removed by the next patch. Nothing is returned when managed_fields was not
+// enabled, as the fields then also contain the fields set by editors.
+func (e *Entry) PatchedFields() []Field {
+	if !e.ManagedFields.ValueBool() {
+		return nil
+	}
+	return e.Field
+}
+
+// BuildFieldsFromAPIResponse builds the Field array from API response. When
+// only the managed fields are handled, the fields and locales which are not
+// configured are ignored.
 func (e *Entry) BuildFieldsFromAPIResponse(entry *sdk.Entry) {
+	var managed map[fieldKey]bool
+	if e.ManagedFields.ValueBool() {
+		managed = fieldKeys(e.Field)
+	}
+
 	e.Field = []Field{}
 
 	// If no fields are present in the response, return early
@@ -96,6 +317,10 @@ func (e *Entry) BuildFieldsFromAPIResponse(entry *sdk.Entry) {
 		subFields := fieldValue.(orderedmap.OrderedMap)
 
 		for _, locale := range subFields.Keys() {
+			if managed != nil && !managed[fieldKey{id: fieldID, locale: locale}] {
+				continue
+			}
+
 			content, _ := subFields.Get(locale)
 
 			// Convert the content back to string representation for storage
@@ -0,0 +1,246 @@
+package entry_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func parseEntry(t *testing.T, data string) *sdk.Entry {
+	result := &sdk.Entry{}
+	err := json.Unmarshal([]byte(data), result)
+	assert.NoError(t, err)
+	return result
+}
+
+func field(id string, locale string, content string) entry.Field {
+	return entry.Field{
+		ID:      types.StringValue(id),
+		Locale:  types.StringValue(locale),
+		Content: types.StringValue(content),
+	}
+}
+
+func TestEntryPatch(t *testing.T) {
+	current := parseEntry(t, `{
+		"fields": {
+			"title": {"en-US": "Old title", "de-DE": "Alter Titel"},
+			"body": {"en-US": "Written by an editor"},
+			"legacy": {"en-US": "Removed"}
+		},
+		"sys": {"id": "entry", "version": 5}
+	}`)
+
+	e := &entry.Entry{
+		Field: []entry.Field{
+			field("title", "en-US", "New title"),
+			field("config", "en-US", `{"enabled":true}`),
+		},
+	}
+
+	previous := []entry.Field{
+		field("title", "en-US", "Old title"),
+		field("legacy", "en-US", "Removed"),
+		field("missing", "en-US", "Already removed by an editor"),
+	}
+
+	patch := e.Patch(current, previous)
+
+	data, err := json.Marshal(patch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/fields/title/en-US", "value": "New title"},
+		{"op": "add", "path": "/fields/config", "value": {"en-US": {"enabled": true}}},
+		{"op": "remove", "path": "/fields/legacy/en-US"}
+	]`, string(data))
+}
+
+func TestEntryPatch_EnableManagedFields(t *testing.T) {
+	current := parseEntry(t, `{
+		"fields": {
+			"title": {"en-US": "Old title"},
+			"body": {"en-US": "Written by an editor"}
+		},
+		"sys": {"id": "entry", "version": 5}
+	}`)
+
+	// The state before managed_fields was enabled contains all fields
+	state := &entry.Entry{
+		ManagedFields: types.BoolValue(false),
+		Field: []entry.Field{
+			field("title", "en-US", "Old title"),
+			field("body", "en-US", "Written by an editor"),
+		},
+	}
+
+	e := &entry.Entry{
+		ManagedFields: types.BoolValue(true),
+		Field: []entry.Field{
+			field("title", "en-US", "New title"),
+		},
+	}
+
+	assert.Nil(t, state.PatchedFields())
+
+	data, err := json.Marshal(e.Patch(current, state.PatchedFields()))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/fields/title/en-US", "value": "New title"}
+	]`, string(data))
+
+	// Once enabled, fields which are no longer configured are removed
+	state.ManagedFields = types.BoolValue(true)
+	state.Field = e.Field
+	e.Field = []entry.Field{}
+
+	data, err = json.Marshal(e.Patch(current, state.PatchedFields()))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "remove", "path": "/fields/title/en-US"}
+	]`, string(data))
+}
+
+func TestEntryPatch_EscapesPath(t *testing.T) {
+	current := parseEntry(t, `{"fields": {"a/b": {"en~US": "old"}}, "sys": {"id": "entry"}}`)
+
+	e := &entry.Entry{
+		Field: []entry.Field{
+			field("a/b", "en~US", "new"),
+		},
+	}
+
+	patch := e.Patch(current, nil)
+	assert.Len(t, patch, 1)
+	assert.Equal(t, "/fields/a~1b/en~0US", patch[0].Path)
+}
+
+func TestEntryBuildFieldsFromAPIResponse_ManagedFields(t *testing.T) {
+	current := parseEntry(t, `{
+		"fields": {
+			"title": {"en-US": "Managed", "de-DE": "Unmanaged locale"},
+			"body": {"en-US": "Unmanaged field"}
+		},
+		"sys": {"id": "entry"}
+	}`)
+
+	e := &entry.Entry{
+		ManagedFields: types.BoolValue(true),
+		Field: []entry.Field{
+			field("title", "en-US", "Outdated"),
+		},
+	}
+	e.BuildFieldsFromAPIResponse(current)
+
+	assert.Equal(t, []entry.Field{field("title", "en-US", "Managed")}, e.Field)
+
+	e.ManagedFields = types.BoolValue(false)
+	e.BuildFieldsFromAPIResponse(current)
+
+	assert.Len(t, e.Field, 3)
+}
+
+func localeSet(locales ...string) types.Set {
+	values := make([]attr.Value, 0, len(locales))
+	for _, locale := range locales {
+		values = append(values, types.StringValue(locale))
+	}
+	return types.SetValueMust(types.StringType, values)
+}
+
+func TestEntryImport_PublishedLocales(t *testing.T) {
+	current := parseEntry(t, `{
+		"fields": {},
+		"sys": {
+			"id": "entry",
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "page"}},
+			"fieldStatus": {"*": {"en-US": "published", "de-DE": "draft", "nl-NL": "changed"}}
+		}
+	}`)
+
+	e := &entry.Entry{PublishedLocales: localeSet("en-US")}
+	e.Import(current)
+	assert.Equal(t, localeSet("en-US", "nl-NL"), e.PublishedLocales)
+
+	// Without configured published locales the whole entry is published
+	e = &entry.Entry{}
+	e.Import(current)
+	assert.Equal(t, types.SetNull(types.StringType), e.PublishedLocales)
+}
+
+func TestEntryImport_PublishedLocalesWithoutFieldStatus(t *testing.T) {
+	current := parseEntry(t, `{
+		"fields": {},
+		"sys": {
+			"id": "entry",
+			"space": {"sys": {"id": "space"}},
+			"environment": {"sys": {"id": "master"}},
+			"contentType": {"sys": {"id": "page"}}
+		}
+	}`)
+
+	e := &entry.Entry{PublishedLocales: localeSet("en-US")}
+	e.Import(current)
+	assert.Equal(t, localeSet("en-US"), e.PublishedLocales)
+
+	e = &entry.Entry{PublishedLocales: types.SetUnknown(types.StringType)}
+	e.Import(current)
+	assert.Equal(t, types.SetNull(types.StringType), e.PublishedLocales)
+
+	e = &entry.Entry{}
+	e.Import(current)
+	assert.Equal(t, types.SetNull(types.StringType), e.PublishedLocales)
+}
+
+func TestEntryPublishLocalesDraft(t *testing.T) {
+	plan := &entry.Entry{Published: types.BoolValue(true), PublishedLocales: localeSet("en-US", "de-DE")}
+	current := &entry.Entry{Published: types.BoolValue(true), PublishedLocales: localeSet("en-US", "nl-NL")}
+
+	draft := plan.PublishLocalesDraft(current, false)
+	data, err := json.Marshal(draft)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"add": {"fields": {"*": ["de-DE"]}}, "remove": {"fields": {"*": ["nl-NL"]}}}`, string(data))
+
+	draft = plan.PublishLocalesDraft(current, true)
+	data, err = json.Marshal(draft)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"add": {"fields": {"*": ["de-DE", "en-US"]}}, "remove": {"fields": {"*": ["nl-NL"]}}}`, string(data))
+
+	assert.Nil(t, plan.PublishLocalesDraft(plan, false))
+
+	// All configured locales are published when the entry is not published
+	current = &entry.Entry{Published: types.BoolValue(false), PublishedLocales: localeSet("en-US", "de-DE")}
+	draft = plan.PublishLocalesDraft(current, false)
+	data, err = json.Marshal(draft)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"add": {"fields": {"*": ["de-DE", "en-US"]}}}`, string(data))
+}
+
+func TestEntryPatch_Tags(t *testing.T) {
+	current := parseEntry(t, `{"fields": {}, "sys": {"id": "entry"}}`)
+
+	e := &entry.Entry{TagsAll: localeSet("governance")}
+
+	patch := e.Patch(current, nil)
+	data, err := json.Marshal(patch)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"op": "add", "path": "/metadata", "value": {"tags": [{"sys": {"type": "Link", "linkType": "Tag", "id": "governance"}}]}}
+	]`, string(data))
+
+	current = parseEntry(t, `{"fields": {}, "metadata": {"tags": [{"sys": {"type": "Link", "linkType": "Tag", "id": "old"}}]}, "sys": {"id": "entry"}}`)
+	patch = e.Patch(current, nil)
+	assert.Len(t, patch, 1)
+	assert.Equal(t, "/metadata/tags", patch[0].Path)
+
+	// Tags which are not configured are left untouched
+	e.TagsAll = types.SetNull(types.StringType)
+	assert.Empty(t, e.Patch(current, nil))
+}
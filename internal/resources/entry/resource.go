@@ -4,14 +4,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -21,9 +25,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &entryResource{}
-	_ resource.ResourceWithConfigure   = &entryResource{}
-	_ resource.ResourceWithImportState = &entryResource{}
+	_ resource.Resource                   = &entryResource{}
+	_ resource.ResourceWithConfigure      = &entryResource{}
+	_ resource.ResourceWithImportState    = &entryResource{}
+	_ resource.ResourceWithModifyPlan     = &entryResource{}
+	_ resource.ResourceWithValidateConfig = &entryResource{}
 )
 
 func NewEntryResource() resource.Resource {
@@ -32,7 +38,10 @@ func NewEntryResource() resource.Resource {
 
 // entryResource is the resource implementation.
 type entryResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
+	onDestroy     string
+	defaultTags   []string
 }
 
 func (e *entryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -90,6 +99,34 @@ func (e *entryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Description: "Whether the entry is archived",
 			},
+			"published_locales": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "The locales of the entry that are published. When set, only these locales are published and " +
+					"the other locales are unpublished. When not set, or no longer set, the whole entry is published. " +
+					"Requires `published` to be true.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"published_version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version of the entry that was last published",
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+				Description: "The status of the entry, one of `draft`, `changed`, `published` or `archived`. When the entry " +
+					"should be published but has changes since it was last published, it is published again on the next apply.",
+			},
+			"managed_fields": schema.BoolAttribute{
+				Optional: true,
+				Description: "Only manage the configured fields and locales of the entry. Updates are written as a patch " +
+					"against the current version of the entry, other fields are left to the editors and ignored when reading the entry.",
+			},
+			"tags":           utils.TagsAttribute("entry"),
+			"tags_all":       utils.TagsAllAttribute("entry"),
+			"on_destroy":     utils.OnDestroyAttribute("entry"),
+			"adopt_existing": utils.AdoptExistingAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"field": schema.ListNestedBlock{
@@ -115,6 +152,33 @@ func (e *entryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (e *entryResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var published types.Bool
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("published"), &published)...)
+
+	var publishedLocales types.Set
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("published_locales"), &publishedLocales)...)
+
+	if response.Diagnostics.HasError() || published.IsUnknown() || publishedLocales.IsNull() {
+		return
+	}
+
+	if !published.ValueBool() {
+		response.Diagnostics.AddAttributeError(
+			path.Root("published_locales"),
+			"Invalid published locales",
+			"The published locales can only be set when published is true, an unpublished entry has no published locales.",
+		)
+	}
+}
+
+func (e *entryResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	utils.PlanRepublish(ctx, request, response)
+	utils.PlanTags(ctx, e.defaultTags, request, response)
+	utils.CheckTagsExist(ctx, e.client, e.defaultTags, request, response)
+	e.validateFields(ctx, request, response)
+}
+
 func (e *entryResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -122,6 +186,9 @@ func (e *entryResource) Configure(_ context.Context, request resource.ConfigureR
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.adoptExisting = data.AdoptExisting
+	e.onDestroy = data.OnDestroy
+	e.defaultTags = data.DefaultTags
 }
 
 func (e *entryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -151,23 +218,59 @@ func (e *entryResource) Create(ctx context.Context, request resource.CreateReque
 
 		entry = resp.JSON201
 	} else {
-		params := &sdk.UpdateEntryParams{
-			XContentfulContentType: plan.ContentTypeID.ValueString(),
-		}
-		resp, err := e.client.UpdateEntryWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.EntryID.ValueString(), params, draft)
-		if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		existing, err := e.adoptableEntry(ctx, &plan)
+		if err != nil {
 			response.Diagnostics.AddError(
 				"Error creating entry",
-				"Could not create entry: "+err.Error(),
+				"Could not retrieve existing entry: "+err.Error(),
 			)
 			return
 		}
 
-		entry = resp.JSON201
+		if existing != nil && plan.ManagedFields.ValueBool() {
+			// Only write the managed fields, the other fields of the adopted
+			// entry are kept
+			entry, err = e.patchEntry(ctx, &plan, existing, nil)
+			if err != nil {
+				response.Diagnostics.AddError(
+					"Error creating entry",
+					"Could not create entry: "+err.Error(),
+				)
+				return
+			}
+		} else {
+			params := &sdk.UpdateEntryParams{
+				XContentfulContentType: plan.ContentTypeID.ValueString(),
+			}
+			expectedStatus := http.StatusCreated
+			if existing != nil {
+				params.XContentfulVersion = existing.Sys.Version
+				expectedStatus = http.StatusOK
+			}
+
+			resp, err := e.client.UpdateEntryWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.EntryID.ValueString(), params, draft)
+			if err := utils.CheckClientResponse(resp, err, expectedStatus); err != nil {
+				response.Diagnostics.AddError(
+					"Error creating entry",
+					"Could not create entry: "+err.Error(),
+				)
+				return
+			}
+
+			entry = resp.JSON201
+			if existing != nil {
+				entry = resp.JSON200
+			}
+		}
+
+		if existing != nil {
+			utils.AddAdoptedWarning(&response.Diagnostics, "entry", plan.EntryID.ValueString())
+		}
 	}
 
-	// Map response to state
-	state := Entry{}
+	// Map response to state, the plan is the base so only the managed fields
+	// are read when managed_fields is enabled
+	state := plan
 	state.Import(entry)
 
 	// Set entry state (published/archived)
@@ -210,31 +313,62 @@ func (e *entryResource) Update(ctx context.Context, request resource.UpdateReque
 		return
 	}
 
-	// Create update parameters with version
-	params := &sdk.UpdateEntryParams{
-		XContentfulVersion: state.Version.ValueInt64(),
-	}
+	var entry *sdk.Entry
+	if plan.ManagedFields.ValueBool() {
+		// Patch the current version of the entry, it is expected to be
+		// changed by editors as well
+		resp, err := e.client.GetEntryWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.ID.ValueString())
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error updating entry",
+				"Could not read entry: "+err.Error(),
+			)
+			return
+		}
 
-	// Update the entry
-	draft := plan.Draft()
-	resp, err := e.client.UpdateEntryWithResponse(
-		ctx,
-		plan.SpaceID.ValueString(),
-		plan.Environment.ValueString(),
-		plan.ID.ValueString(),
-		params,
-		draft,
-	)
+		entry, err = e.patchEntry(ctx, &plan, resp.JSON200, state.PatchedFields())
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error updating entry",
+				"Could not update entry: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		// Create update parameters with version
+		params := &sdk.UpdateEntryParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		}
 
-	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
-		response.Diagnostics.AddError(
-			"Error updating entry",
-			"Could not update entry: "+err.Error(),
+		// Update the entry
+		draft := plan.Draft()
+		resp, err := e.client.UpdateEntryWithResponse(
+			ctx,
+			plan.SpaceID.ValueString(),
+			plan.Environment.ValueString(),
+			plan.ID.ValueString(),
+			params,
+			draft,
 		)
-		return
+
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error updating entry",
+				"Could not update entry: "+err.Error(),
+			)
+			return
+		}
+
+		entry = resp.JSON200
 	}
 
-	state.Import(resp.JSON200)
+	state.AdoptExisting = plan.AdoptExisting
+	state.ManagedFields = plan.ManagedFields
+	state.OnDestroy = plan.OnDestroy
+	state.Tags = plan.Tags
+	state.TagsAll = plan.TagsAll
+	state.Field = plan.Field
+	state.Import(entry)
 
 	// Set entry state (published/archived)
 	if err := e.setEntryState(ctx, &state, &plan); err != nil {
@@ -279,6 +413,13 @@ func (e *entryResource) Delete(ctx context.Context, request resource.DeleteReque
 
 	state.Import(resp.JSON200)
 
+	// The entry is kept in Contentful unless it should be deleted, it is only
+	// removed from the state
+	onDestroy := utils.OnDestroy(e.onDestroy, state.OnDestroy)
+	if onDestroy == utils.OnDestroyAbandon {
+		return
+	}
+
 	if state.Published.ValueBool() {
 		resp, err := e.client.UnpublishEntryWithResponse(
 			ctx,
@@ -299,6 +440,33 @@ func (e *entryResource) Delete(ctx context.Context, request resource.DeleteReque
 		state.Import(resp.JSON200)
 	}
 
+	if onDestroy == utils.OnDestroyUnpublish {
+		return
+	}
+
+	if onDestroy == utils.OnDestroyArchive {
+		if state.Archived.ValueBool() {
+			return
+		}
+
+		resp, err := e.client.ArchiveEntryWithResponse(
+			ctx,
+			state.SpaceID.ValueString(),
+			state.Environment.ValueString(),
+			state.ID.ValueString(),
+			&sdk.ArchiveEntryParams{
+				XContentfulVersion: state.Version.ValueInt64(),
+			},
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddError(
+				"Error deleting entry",
+				"Could not archive entry: "+err.Error(),
+			)
+		}
+		return
+	}
+
 	// Create delete parameters with latest version
 	params := &sdk.DeleteEntryParams{
 		XContentfulVersion: int64(state.Version.ValueInt64()),
@@ -401,6 +569,77 @@ func (e *entryResource) doRead(ctx context.Context, entry *Entry, state *tfsdk.S
 	d.Append(state.Set(ctx, entry)...)
 }
 
+// patchEntry writes the configured fields to the given current version of the
+// entry with a JSON patch. Fields which were managed before but are no longer
+// configured are removed.
+func (e *entryResource) patchEntry(ctx context.Context, plan *Entry, current *sdk.Entry, previous []Field) (*sdk.Entry, error) {
+	patch := plan.Patch(current, previous)
+	if len(patch) == 0 {
+		return current, nil
+	}
+
+	params := &sdk.PatchEntryParams{
+		XContentfulVersion: current.Sys.Version,
+	}
+
+	resp, err := e.client.PatchEntryWithApplicationJSONPatchPlusJSONBodyWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		current.Sys.Id,
+		params,
+		patch,
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// adoptableEntry returns the entry with the id of the plan when it already
+// exists and adopting existing entries is enabled, nil otherwise
+func (e *entryResource) adoptableEntry(ctx context.Context, plan *Entry) (*sdk.Entry, error) {
+	if !utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+		return nil, nil
+	}
+
+	resp, err := e.client.GetEntryWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.EntryID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	return resp.JSON200, nil
+}
+
+// publishEntry publishes the entry, or only the given locales of the entry
+func (e *entryResource) publishEntry(ctx context.Context, state *Entry, locales sdk.EntryPublishLocales) error {
+	resp, err := e.client.PublishEntryWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.PublishEntryParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+		locales,
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+
+	state.Import(resp.JSON200)
+	return nil
+}
+
 // setEntryState handles publishing and archiving based on the desired state
 func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *Entry) error {
 
@@ -408,24 +647,32 @@ func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *E
 	isCurrentlyPublished := state.Published.ValueBool()
 	shouldBePublished := plan.Published.ValueBool()
 
+	// A published entry with changes since its publication is published again
+	hasUnpublishedChanges := state.Status.ValueString() == utils.StatusChanged
+
 	// Handle archiving state
 	isCurrentlyArchived := state.Archived.ValueBool()
 	shouldBeArchived := plan.Archived.ValueBool()
 
-	if shouldBePublished && !isCurrentlyPublished {
-		resp, err := e.client.PublishEntryWithResponse(
-			ctx,
-			state.SpaceID.ValueString(),
-			state.Environment.ValueString(),
-			state.ID.ValueString(),
-			&sdk.PublishEntryParams{
-				XContentfulVersion: state.Version.ValueInt64(),
-			},
-		)
-		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+	// When the published locales are configured only these locales are
+	// published, the other locales are unpublished. When they are no longer
+	// configured the whole entry is published again.
+	publishLocales := shouldBePublished && !plan.PublishedLocales.IsNull() && !plan.PublishedLocales.IsUnknown()
+	publishAllLocales := shouldBePublished && plan.PublishedLocales.IsNull() && !state.PublishedLocales.IsNull()
+	if plan.PublishedLocales.IsNull() {
+		state.PublishedLocales = types.SetNull(types.StringType)
+	}
+
+	if publishLocales {
+		if draft := plan.PublishLocalesDraft(state, hasUnpublishedChanges); draft != nil {
+			if err := e.publishEntry(ctx, state, *draft); err != nil {
+				return err
+			}
+		}
+	} else if shouldBePublished && (!isCurrentlyPublished || hasUnpublishedChanges || publishAllLocales) {
+		if err := e.publishEntry(ctx, state, sdk.EntryPublishLocales{}); err != nil {
 			return err
 		}
-		state.Import(resp.JSON200)
 	} else if !shouldBePublished && isCurrentlyPublished {
 		resp, err := e.client.UnpublishEntryWithResponse(
 			ctx,
@@ -474,3 +721,73 @@ func (e *entryResource) setEntryState(ctx context.Context, state *Entry, plan *E
 
 	return nil
 }
+
+// validateFields validates the planned fields against the content type of the
+// entry, so invalid fields are reported while planning instead of failing the
+// apply. Nothing is validated when the content type does not exist yet, e.g.
+// because it is created in the same apply.
+func (e *entryResource) validateFields(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if e.client == nil || request.Plan.Raw.IsNull() || request.Plan.Raw.Equal(request.State.Raw) {
+		return
+	}
+
+	var fields types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("field"), &fields)...)
+	if response.Diagnostics.HasError() || fields.IsUnknown() || slices.ContainsFunc(fields.Elements(), attr.Value.IsUnknown) {
+		return
+	}
+
+	var plan Entry
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() || plan.SpaceID.IsUnknown() || plan.Environment.IsUnknown() || plan.ContentTypeID.IsUnknown() {
+		return
+	}
+
+	spaceID, environment := plan.SpaceID.ValueString(), plan.Environment.ValueString()
+	resp, err := e.client.GetContentTypeWithResponse(ctx, spaceID, environment, plan.ContentTypeID.ValueString())
+	if resp != nil && resp.StatusCode() == http.StatusNotFound {
+		return
+	}
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error validating entry",
+			"Could not read content type: "+err.Error(),
+		)
+		return
+	}
+
+	locales, err := e.getLocales(ctx, spaceID, environment)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error validating entry",
+			"Could not read locales: "+err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(plan.Validate(resp.JSON200, locales)...)
+}
+
+func (e *entryResource) getLocales(ctx context.Context, spaceID string, environment string) ([]sdk.Locale, error) {
+	var locales []sdk.Locale
+
+	limit := 100
+	for skip := 0; ; skip += limit {
+		resp, err := e.client.GetAllLocalesWithResponse(ctx, spaceID, environment, &sdk.GetAllLocalesParams{
+			Limit: &limit,
+			Skip:  utils.Pointer(skip),
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200.Items == nil {
+			return locales, nil
+		}
+
+		locales = append(locales, *resp.JSON200.Items...)
+		if len(*resp.JSON200.Items) < limit {
+			return locales, nil
+		}
+	}
+}
@@ -2,6 +2,7 @@ package entry_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/iancoleman/orderedmap"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/labd/terraform-provider-contentful/internal/acctest"
@@ -50,6 +52,8 @@ func TestEntryResource_Basic(t *testing.T) {
 						assert.Equal(t, spaceID, entry.Sys.Space.Sys.Id)
 						assert.NotNil(t, entry.Sys.PublishedAt)
 					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "published"),
+					resource.TestCheckResourceAttrSet(resourceName, "published_version"),
 				),
 			},
 			{
@@ -61,6 +65,7 @@ func TestEntryResource_Basic(t *testing.T) {
 						assert.Equal(t, spaceID, entry.Sys.Space.Sys.Id)
 						assert.Nil(t, entry.Sys.PublishedAt)
 					}),
+					resource.TestCheckResourceAttr(resourceName, "status", "draft"),
 				),
 			},
 			{
@@ -82,6 +87,88 @@ func TestEntryResource_Basic(t *testing.T) {
 	})
 }
 
+func TestEntryResource_PublishedLocales(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry.myentry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulEntryDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEntryPublishedLocalesConfig(spaceID, `published_locales = ["en-US"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "published_locales.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "published_locales.0", "en-US"),
+					resource.TestCheckResourceAttr(resourceName, "status", "published"),
+				),
+			},
+			{
+				// Without published locales the whole entry is published
+				Config: testEntryPublishedLocalesConfig(spaceID, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "published_locales.#"),
+					resource.TestCheckResourceAttr(resourceName, "status", "published"),
+					testAccCheckContentfulEntryExists(t, resourceName, func(t *testing.T, entry *sdk.Entry) {
+						assert.NotNil(t, entry.Sys.PublishedAt)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestEntryResource_AdoptExisting(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry.myentry"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulEntryDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEntryAdoptConfig(spaceID, false),
+			},
+			{
+				PreConfig: func() {
+					fields := orderedmap.New()
+					fields.Set("field1", map[string]any{"en-US": "Existing entry"})
+
+					client := acctest.GetClient()
+					resp, err := client.UpdateEntryWithResponse(context.Background(), spaceID, "master", "mytestentry-adopt", &sdk.UpdateEntryParams{
+						XContentfulContentType: "tf_test_adopt",
+					}, sdk.EntryDraft{Fields: fields})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating entry: %d", resp.StatusCode())
+					}
+				},
+				Config: testEntryAdoptConfig(spaceID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulEntryExists(t, resourceName, func(t *testing.T, entry *sdk.Entry) {
+						assert.Equal(t, "mytestentry-adopt", entry.Sys.Id)
+						assert.Greater(t, entry.Sys.Version, int64(1))
+
+						value, _ := entry.Fields.Get("field1")
+						content, err := json.Marshal(value)
+						assert.NoError(t, err)
+						assert.JSONEq(t, `{"en-US": "Adopted entry"}`, string(content))
+					}),
+				),
+			},
+		},
+	})
+}
+
 type assertFunc func(*testing.T, *sdk.Entry)
 
 func testAccCheckContentfulEntryExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
@@ -170,31 +257,31 @@ resource "contentful_contenttype" "mycontenttype" {
   description = "Terraform Acc Test Content Type"
   display_field = "field1"
 
-  fields = [
-		{
-			disabled  = false
-			id        = "field1"
-			localized = false
-			name      = "Field 1"
-			omitted   = false
-			required  = true
-			type      = "Text"
-		},
-		{
-			disabled  = false
-			id        = "field2"
-			localized = false
-			name      = "Field 2"
-			omitted   = false
-			required  = true
-			type      = "Text"
-		},
-		{
-			id       = "field3"
-			name     = "Field 3"
-			type     = "RichText"
-		}
-	]
+  field = {
+    field1 = {
+      position  = 0
+      disabled  = false
+      localized = false
+      name      = "Field 1"
+      omitted   = false
+      required  = true
+      type      = "Text"
+    }
+    field2 = {
+      position  = 1
+      disabled  = false
+      localized = false
+      name      = "Field 2"
+      omitted   = false
+      required  = true
+      type      = "Text"
+    }
+    field3 = {
+      position = 2
+      name     = "Field 3"
+      type     = "RichText"
+    }
+  }
 }
 
 resource "contentful_entry" "myentry" {
@@ -251,31 +338,31 @@ resource "contentful_contenttype" "mycontenttype" {
   description = "Terraform Acc Test Content Type"
   display_field = "field1"
 
-	fields = [
-		{
+	field = {
+		field1 = {
+			position  = 0
 			disabled  = false
-			id        = "field1"
 			localized = false
 			name      = "Field 1"
 			omitted   = false
 			required  = true
 			type      = "Text"
-		},
-		{
+		}
+		field2 = {
+			position  = 1
 			disabled  = false
-			id        = "field2"
 			localized = false
 			name      = "Field 2"
 			omitted   = false
 			required  = true
 			type      = "Text"
-		},
-		{
-			id       = "field3"
+		}
+		field3 = {
+			position = 2
 			name     = "Field 3"
 			type     = "RichText"
 		}
-	]
+	}
 }
 
 resource "contentful_entry" "myentry" {
@@ -321,3 +408,81 @@ resource "contentful_entry" "myentry" {
 }
 `, spaceID, spaceID)
 }
+
+func testEntryAdoptConfig(spaceID string, withEntry bool) string {
+	entry := ""
+	if withEntry {
+		entry = fmt.Sprintf(`
+resource "contentful_entry" "myentry" {
+  entry_id       = "mytestentry-adopt"
+  space_id       = "%s"
+  environment    = "master"
+  contenttype_id = "tf_test_adopt"
+  adopt_existing = true
+  field {
+    id      = "field1"
+    content = "Adopted entry"
+    locale  = "en-US"
+  }
+  published  = false
+  archived   = false
+  depends_on = [contentful_contenttype.mycontenttype]
+}
+`, spaceID)
+	}
+
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id      = "%s"
+  name          = "tf_test_adopt"
+  environment   = "master"
+  description   = "Terraform Acc Test Content Type for adopting entries"
+  display_field = "field1"
+
+  field = {
+    field1 = {
+      position = 0
+      name     = "Field 1"
+      type     = "Text"
+    }
+  }
+}
+%s`, spaceID, entry)
+}
+
+func testEntryPublishedLocalesConfig(spaceID string, publishedLocales string) string {
+	return fmt.Sprintf(`
+resource "contentful_contenttype" "mycontenttype" {
+  space_id      = "%s"
+  name          = "tf_test_locales"
+  environment   = "master"
+  description   = "Terraform Acc Test Content Type for published locales"
+  display_field = "field1"
+
+  field = {
+    field1 = {
+      position  = 0
+      name      = "Field 1"
+      type      = "Text"
+      localized = true
+    }
+  }
+}
+
+resource "contentful_entry" "myentry" {
+  entry_id       = "mytestentry-locales"
+  space_id       = "%s"
+  environment    = "master"
+  contenttype_id = "tf_test_locales"
+  field {
+    id      = "field1"
+    content = "Hello, World!"
+    locale  = "en-US"
+  }
+  published  = true
+  archived   = false
+  %s
+  depends_on = [contentful_contenttype.mycontenttype]
+}
+`, spaceID, spaceID, publishedLocales)
+}
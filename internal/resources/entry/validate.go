@@ -0,0 +1,331 @@
+package entry
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Validate checks the configured fields of the entry against the fields of
+// its content type and the locales of its environment. Fields of which the
+// id, locale or content is not known yet are not checked. Required fields are
+// only checked when the entry is published, since drafts may be incomplete.
+func (e *Entry) Validate(contentType *sdk.ContentType, locales []sdk.Locale) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	defaultLocale := ""
+	localeCodes := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		localeCodes = append(localeCodes, locale.Code)
+		if locale.Default != nil && *locale.Default {
+			defaultLocale = locale.Code
+		}
+	}
+
+	contentTypeFields := make(map[string]sdk.Field, len(contentType.Fields))
+	for _, field := range contentType.Fields {
+		contentTypeFields[field.Id] = field
+	}
+
+	for i, field := range e.Field {
+		fieldPath := path.Root("field").AtListIndex(i)
+		if field.ID.IsUnknown() || field.Locale.IsUnknown() {
+			continue
+		}
+
+		definition, ok := contentTypeFields[field.ID.ValueString()]
+		if !ok {
+			diags.AddAttributeError(
+				fieldPath.AtName("id"),
+				"Unknown field",
+				fmt.Sprintf("The content type %q has no field %q.", contentType.Sys.Id, field.ID.ValueString()),
+			)
+			continue
+		}
+
+		locale := field.Locale.ValueString()
+		if !slices.Contains(localeCodes, locale) {
+			diags.AddAttributeError(
+				fieldPath.AtName("locale"),
+				"Unknown locale",
+				fmt.Sprintf("The locale %q of field %q does not exist in the environment, expected one of: %s.",
+					locale, definition.Id, strings.Join(localeCodes, ", ")),
+			)
+			continue
+		}
+
+		if !definition.Localized && defaultLocale != "" && locale != defaultLocale {
+			diags.AddAttributeError(
+				fieldPath.AtName("locale"),
+				"Field is not localized",
+				fmt.Sprintf("The field %q is not localized, it can only be set for the default locale %q.", definition.Id, defaultLocale),
+			)
+			continue
+		}
+
+		if field.Content.IsUnknown() || field.Content.IsNull() {
+			continue
+		}
+
+		value := ParseContentValue(field.Content.ValueString())
+		for _, message := range validateFieldValue(definition, value) {
+			diags.AddAttributeError(fieldPath.AtName("content"), "Invalid field value", message)
+		}
+	}
+
+	if e.Published.ValueBool() && !e.ManagedFields.ValueBool() {
+		diags.Append(e.validateRequired(contentType, locales, defaultLocale)...)
+	}
+
+	return diags
+}
+
+// validateRequired reports the required fields which are not configured for
+// the default locale, or for a locale which does not allow empty fields
+func (e *Entry) validateRequired(contentType *sdk.ContentType, locales []sdk.Locale, defaultLocale string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured := fieldKeys(e.Field)
+	for _, field := range e.Field {
+		// The missing field may be the one of which the id is not known yet
+		if field.ID.IsUnknown() || field.Locale.IsUnknown() {
+			return diags
+		}
+	}
+
+	published := utils.SetStrings(e.PublishedLocales)
+	for _, field := range contentType.Fields {
+		if !field.Required || (field.Omitted != nil && *field.Omitted) {
+			continue
+		}
+
+		for _, locale := range locales {
+			if locale.Code != defaultLocale && (!field.Localized || locale.Optional) {
+				continue
+			}
+			if len(published) > 0 && !slices.Contains(published, locale.Code) {
+				continue
+			}
+
+			if !configured[fieldKey{id: field.Id, locale: locale.Code}] {
+				diags.AddAttributeError(
+					path.Root("field"),
+					"Missing required field",
+					fmt.Sprintf("The field %q of content type %q is required for locale %q to publish the entry.",
+						field.Id, contentType.Sys.Id, locale.Code),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// validateFieldValue returns the problems of the value of a field, checking
+// its type and the size, regexp and in validations of the field
+func validateFieldValue(field sdk.Field, value any) []string {
+	if value == nil {
+		return nil
+	}
+
+	if !hasFieldType(string(field.Type), value) {
+		return []string{fmt.Sprintf("The field %q is of type %s, got: %s.", field.Id, field.Type, describeValue(value))}
+	}
+
+	messages := validateValue(field.Id, field.Validations, value)
+
+	items, ok := value.([]any)
+	if !ok || field.Items == nil {
+		return messages
+	}
+
+	itemType, err := field.Items.Discriminator()
+	if err != nil {
+		return messages
+	}
+
+	var itemValidations *[]sdk.FieldValidation
+	if symbol, err := field.Items.AsFieldItemSymbol(); err == nil {
+		itemValidations = symbol.Validations
+	}
+
+	for _, item := range items {
+		if !hasFieldType(itemType, item) {
+			messages = append(messages, fmt.Sprintf("The items of field %q are of type %s, got: %s.", field.Id, itemType, describeValue(item)))
+			continue
+		}
+
+		if itemType == "Symbol" {
+			messages = append(messages, validateValue(field.Id, itemValidations, item)...)
+		}
+	}
+
+	return messages
+}
+
+func hasFieldType(fieldType string, value any) bool {
+	switch fieldType {
+	case "Symbol", "Text", "Date":
+		_, ok := value.(string)
+		return ok
+	case "Integer":
+		number, ok := value.(float64)
+		return ok && number == float64(int64(number))
+	case "Number":
+		_, ok := value.(float64)
+		return ok
+	case "Boolean":
+		_, ok := value.(bool)
+		return ok
+	case "Array":
+		_, ok := value.([]any)
+		return ok
+	case "Location":
+		location, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		_, lat := location["lat"].(float64)
+		_, lon := location["lon"].(float64)
+		return lat && lon
+	case "RichText":
+		document, ok := value.(map[string]any)
+		return ok && document["nodeType"] == "document"
+	case "Link", "ResourceLink":
+		link, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		_, ok = link["sys"].(map[string]any)
+		return ok
+	default:
+		// Object fields accept any JSON value
+		return true
+	}
+}
+
+func describeValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return strconv.Quote(v)
+	case float64:
+		return "number " + formatNumber(v)
+	case bool:
+		return "boolean " + strconv.FormatBool(v)
+	case []any:
+		return "a list"
+	default:
+		return "an object"
+	}
+}
+
+func formatNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// validateValue checks a single value against the size, regexp and in
+// validations. Other validations are left to Contentful.
+func validateValue(id string, validations *[]sdk.FieldValidation, value any) []string {
+	if validations == nil {
+		return nil
+	}
+
+	var messages []string
+	fail := func(validation sdk.FieldValidation, message string) {
+		if validation.Message != nil && *validation.Message != "" {
+			message = fmt.Sprintf("The field %q is invalid: %s", id, *validation.Message)
+		}
+		messages = append(messages, message)
+	}
+
+	for _, validation := range *validations {
+		if validation.Size != nil {
+			size, unit := -1, ""
+			switch v := value.(type) {
+			case string:
+				size, unit = utf8.RuneCountInString(v), "characters"
+			case []any:
+				size, unit = len(v), "items"
+			}
+
+			if size >= 0 && !inRange(validation.Size, float64(size)) {
+				fail(validation, fmt.Sprintf("The field %q must have %s %s, got %d.", id, describeRange(validation.Size), unit, size))
+			}
+		}
+
+		if validation.Regexp != nil {
+			if str, ok := value.(string); ok {
+				// Patterns which are not supported by Go, e.g. lookarounds, are
+				// left to Contentful
+				pattern, err := compilePattern(validation.Regexp)
+				if err == nil && !pattern.MatchString(str) {
+					fail(validation, fmt.Sprintf("The field %q must match the pattern %q, got %q.", id, validation.Regexp.Pattern, str))
+				}
+			}
+		}
+
+		if validation.In != nil {
+			var str string
+			switch v := value.(type) {
+			case string:
+				str = v
+			case float64:
+				str = formatNumber(v)
+			default:
+				continue
+			}
+
+			if !slices.Contains(*validation.In, str) {
+				fail(validation, fmt.Sprintf("The field %q must be one of: %s, got %q.", id, strings.Join(*validation.In, ", "), str))
+			}
+		}
+	}
+
+	return messages
+}
+
+func inRange(r *sdk.RangeMinMax, value float64) bool {
+	return (r.Min == nil || value >= *r.Min) && (r.Max == nil || value <= *r.Max)
+}
+
+func describeRange(r *sdk.RangeMinMax) string {
+	switch {
+	case r.Min != nil && r.Max != nil:
+		return fmt.Sprintf("between %s and %s", formatNumber(*r.Min), formatNumber(*r.Max))
+	case r.Min != nil:
+		return "at least " + formatNumber(*r.Min)
+	case r.Max != nil:
+		return "at most " + formatNumber(*r.Max)
+	default:
+		return "any number of"
+	}
+}
+
+// compilePattern compiles the regular expression of a regexp validation,
+// which uses the flags of JavaScript regular expressions
+func compilePattern(validation *sdk.RegexValidationValue) (*regexp.Regexp, error) {
+	flags := ""
+	if validation.Flags != nil {
+		for _, flag := range "ims" {
+			if strings.ContainsRune(*validation.Flags, flag) {
+				flags += string(flag)
+			}
+		}
+	}
+
+	pattern := validation.Pattern
+	if flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+
+	return regexp.Compile(pattern)
+}
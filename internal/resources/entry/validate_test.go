@@ -0,0 +1,136 @@
+package entry_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const testContentType = `{
+	"name": "Article",
+	"fields": [
+		{"id": "title", "name": "Title", "type": "Symbol", "localized": true, "required": true,
+			"validations": [{"size": {"min": 3, "max": 10}}]},
+		{"id": "slug", "name": "Slug", "type": "Symbol", "localized": false, "required": true,
+			"validations": [{"regexp": {"pattern": "^[a-z-]+$"}, "message": "Only lowercase letters and dashes"}]},
+		{"id": "category", "name": "Category", "type": "Symbol", "localized": false, "required": false,
+			"validations": [{"in": ["news", "blog"]}]},
+		{"id": "rating", "name": "Rating", "type": "Integer", "localized": false, "required": false},
+		{"id": "keywords", "name": "Keywords", "type": "Array", "localized": false, "required": false,
+			"items": {"type": "Symbol", "validations": [{"in": ["go", "terraform"]}]},
+			"validations": [{"size": {"max": 2}}]}
+	],
+	"sys": {"id": "article", "type": "ContentType"}
+}`
+
+const testLocales = `[
+	{"code": "en-US", "name": "English", "default": true, "optional": false, "sys": {"id": "en", "type": "Locale"}},
+	{"code": "nl-NL", "name": "Dutch", "optional": false, "sys": {"id": "nl", "type": "Locale"}},
+	{"code": "de-DE", "name": "German", "optional": true, "sys": {"id": "de", "type": "Locale"}}
+]`
+
+func parseValidationFixtures(t *testing.T) (*sdk.ContentType, []sdk.Locale) {
+	contentType := &sdk.ContentType{}
+	assert.NoError(t, json.Unmarshal([]byte(testContentType), contentType))
+
+	var locales []sdk.Locale
+	assert.NoError(t, json.Unmarshal([]byte(testLocales), &locales))
+
+	return contentType, locales
+}
+
+func TestEntryValidate(t *testing.T) {
+	contentType, locales := parseValidationFixtures(t)
+
+	e := &entry.Entry{
+		Published: types.BoolValue(true),
+		Field: []entry.Field{
+			field("title", "en-US", "Hello"),
+			field("title", "nl-NL", "Hallo"),
+			field("slug", "en-US", "hello-world"),
+			field("category", "en-US", "news"),
+			field("rating", "en-US", "4"),
+			field("keywords", "en-US", `["go", "terraform"]`),
+		},
+	}
+
+	diags := e.Validate(contentType, locales)
+	assert.False(t, diags.HasError(), diags)
+}
+
+func TestEntryValidate_Errors(t *testing.T) {
+	contentType, locales := parseValidationFixtures(t)
+
+	e := &entry.Entry{
+		Published: types.BoolValue(false),
+		Field: []entry.Field{
+			field("subtitle", "en-US", "Unknown"),
+			field("title", "fr-FR", "Bonjour"),
+			field("slug", "nl-NL", "hallo"),
+			field("title", "en-US", "Hi"),
+			field("slug", "en-US", "Hello World"),
+			field("category", "en-US", "sports"),
+			field("rating", "en-US", "4.5"),
+			field("keywords", "en-US", `["go", "java", "terraform"]`),
+		},
+	}
+
+	diags := e.Validate(contentType, locales)
+
+	var paths []path.Path
+	for _, d := range diags.Errors() {
+		if withPath, ok := d.(interface{ Path() path.Path }); ok {
+			paths = append(paths, withPath.Path())
+		}
+	}
+
+	fieldPath := func(i int, name string) path.Path {
+		return path.Root("field").AtListIndex(i).AtName(name)
+	}
+
+	assert.Equal(t, []path.Path{
+		fieldPath(0, "id"),
+		fieldPath(1, "locale"),
+		fieldPath(2, "locale"),
+		fieldPath(3, "content"),
+		fieldPath(4, "content"),
+		fieldPath(5, "content"),
+		fieldPath(6, "content"),
+		fieldPath(7, "content"),
+		fieldPath(7, "content"),
+	}, paths)
+
+	assert.Equal(t, `The field "slug" is invalid: Only lowercase letters and dashes`, diags.Errors()[4].Detail())
+	assert.Equal(t, `The field "rating" is of type Integer, got: number 4.5.`, diags.Errors()[6].Detail())
+}
+
+func TestEntryValidate_Required(t *testing.T) {
+	contentType, locales := parseValidationFixtures(t)
+
+	e := &entry.Entry{
+		Published: types.BoolValue(true),
+		Field: []entry.Field{
+			field("title", "en-US", "Hello"),
+		},
+	}
+
+	diags := e.Validate(contentType, locales)
+	assert.Len(t, diags.Errors(), 2)
+	assert.Equal(t, `The field "title" of content type "article" is required for locale "nl-NL" to publish the entry.`, diags.Errors()[0].Detail())
+	assert.Equal(t, `The field "slug" of content type "article" is required for locale "en-US" to publish the entry.`, diags.Errors()[1].Detail())
+
+	// Drafts may be incomplete
+	e.Published = types.BoolValue(false)
+	assert.False(t, e.Validate(contentType, locales).HasError())
+
+	// Other fields are managed by the editors
+	e.Published = types.BoolValue(true)
+	e.ManagedFields = types.BoolValue(true)
+	assert.False(t, e.Validate(contentType, locales).HasError())
+}
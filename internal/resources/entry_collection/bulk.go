@@ -0,0 +1,118 @@
+package entry_collection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// bulkActionLimit is the maximum number of entities of a single bulk action
+const bulkActionLimit = 200
+
+func bulkActionDraft(entities []sdk.VersionedLink) sdk.BulkActionDraft {
+	draft := sdk.BulkActionDraft{}
+	draft.Entities.Sys.Type = "Array"
+	draft.Entities.Items = entities
+	return draft
+}
+
+// chunk splits the ids into batches of at most size ids
+func chunk(ids []string, size int) [][]string {
+	var result [][]string
+	for len(ids) > size {
+		result = append(result, ids[:size])
+		ids = ids[size:]
+	}
+	if len(ids) > 0 {
+		result = append(result, ids)
+	}
+	return result
+}
+
+// validateEntries validates whether the entities can be published, the
+// validation errors are reported per entry
+func (e *entryCollectionResource) validateEntries(ctx context.Context, collection *EntryCollection, entities []sdk.VersionedLink, d *diag.Diagnostics) bool {
+	draft := sdk.BulkActionValidateDraft{
+		Action:   sdk.BulkActionValidateDraftAction("publish"),
+		Entities: bulkActionDraft(entities).Entities,
+	}
+
+	resp, err := e.client.ValidateBulkActionWithResponse(ctx, collection.SpaceID.ValueString(), collection.Environment.ValueString(), draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		d.AddError(
+			"Error validating entries",
+			"Could not validate entries: "+err.Error(),
+		)
+		return false
+	}
+
+	return e.waitForBulkAction(ctx, collection, resp.JSON201, "validate", d)
+}
+
+func (e *entryCollectionResource) unpublishEntries(ctx context.Context, collection *EntryCollection, entities []sdk.VersionedLink, d *diag.Diagnostics) bool {
+	resp, err := e.client.UnpublishBulkActionWithResponse(ctx, collection.SpaceID.ValueString(), collection.Environment.ValueString(), bulkActionDraft(entities))
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		d.AddError(
+			"Error unpublishing entries",
+			"Could not unpublish entries: "+err.Error(),
+		)
+		return false
+	}
+
+	return e.waitForBulkAction(ctx, collection, resp.JSON201, "unpublish", d)
+}
+
+// waitForBulkAction polls the bulk action until it succeeded or failed. The
+// errors of a failed bulk action are reported per entry.
+func (e *entryCollectionResource) waitForBulkAction(ctx context.Context, collection *EntryCollection, action *sdk.BulkAction, name string, d *diag.Diagnostics) bool {
+	action, err := utils.WaitForAction(ctx, action, func(action *sdk.BulkAction) string {
+		return string(action.Sys.Status)
+	}, func() (*sdk.BulkAction, error) {
+		resp, err := e.client.GetBulkActionWithResponse(ctx, collection.SpaceID.ValueString(), collection.Environment.ValueString(), action.Sys.Id)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	})
+
+	if err != nil {
+		d.AddError(
+			fmt.Sprintf("Error waiting for %s bulk action", name),
+			fmt.Sprintf("Could not %s entries: %s", name, err.Error()),
+		)
+		return false
+	}
+
+	if string(action.Sys.Status) == utils.ActionFailed {
+		addBulkActionErrors(action, name, d)
+		return false
+	}
+
+	return true
+}
+
+// addBulkActionErrors reports the errors of a failed bulk action, the errors
+// of an entity are reported on the entry
+func addBulkActionErrors(action *sdk.BulkAction, name string, d *diag.Diagnostics) {
+	summary := fmt.Sprintf("Error in %s bulk action", name)
+
+	for _, entityError := range utils.ActionErrors(action.Error) {
+		if entityError.Entity == nil {
+			d.AddError(summary, fmt.Sprintf("Bulk action %s failed: %s", action.Sys.Id, entityError.Message))
+			continue
+		}
+
+		id := entityError.Entity.Sys.Id
+		d.AddAttributeError(
+			path.Root("entries").AtMapKey(id),
+			summary,
+			fmt.Sprintf("Could not %s entry %s: %s", name, id, entityError.Message),
+		)
+	}
+}
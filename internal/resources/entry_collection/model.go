@@ -0,0 +1,126 @@
+package entry_collection
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iancoleman/orderedmap"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// EntryCollection is the main resource schema data
+type EntryCollection struct {
+	ID            types.String                    `tfsdk:"id"`
+	SpaceID       types.String                    `tfsdk:"space_id"`
+	Environment   types.String                    `tfsdk:"environment"`
+	ContentTypeID types.String                    `tfsdk:"contenttype_id"`
+	Entries       map[string]jsontypes.Normalized `tfsdk:"entries"`
+	Published     types.Bool                      `tfsdk:"published"`
+	AdoptExisting types.Bool                      `tfsdk:"adopt_existing"`
+	Versions      types.Map                       `tfsdk:"versions"`
+}
+
+// EntryIDs returns the ids of the configured entries in a stable order
+func (c *EntryCollection) EntryIDs() []string {
+	ids := make([]string, 0, len(c.Entries))
+	for id := range c.Entries {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Draft returns the draft of the entry with the given id. The configured value
+// contains the fields of the entry, keyed by field id and locale.
+func (c *EntryCollection) Draft(id string) (sdk.EntryDraft, error) {
+	fields := orderedmap.New()
+	if err := json.Unmarshal([]byte(c.Entries[id].ValueString()), fields); err != nil {
+		return sdk.EntryDraft{}, fmt.Errorf("the fields of entry %s must be a JSON object keyed by field id and locale: %w", id, err)
+	}
+
+	return sdk.EntryDraft{
+		Fields: fields,
+	}, nil
+}
+
+// Changed returns whether the configured fields of the entry with the given id
+// differ from the fields of the current version of the entry. The configured
+// fields are authoritative: fields and locales which are only set on the entry
+// are a change as well, as writing the entry removes them.
+func (c *EntryCollection) Changed(ctx context.Context, id string, current *sdk.Entry) (bool, error) {
+	fields, err := json.Marshal(current.Fields)
+	if err != nil {
+		return false, err
+	}
+
+	equal, d := c.Entries[id].StringSemanticEquals(ctx, jsontypes.NewNormalizedValue(string(fields)))
+	if d.HasError() {
+		return false, fmt.Errorf("could not compare the fields of entry %s", id)
+	}
+
+	return !equal, nil
+}
+
+// Import reads the given entries, keyed by entry id, into the collection. Only
+// the entries which are in the collection are read, unless all is set.
+func (c *EntryCollection) Import(entries map[string]sdk.Entry, all bool) error {
+	result := map[string]jsontypes.Normalized{}
+	versions := map[string]attr.Value{}
+	allPublished, anyPublished := true, false
+
+	for _, entry := range entries {
+		if _, ok := c.Entries[entry.Sys.Id]; !ok && !all {
+			continue
+		}
+
+		fields, err := json.Marshal(utils.SortOrderedMapRecursively(entry.Fields))
+		if err != nil {
+			return fmt.Errorf("could not read the fields of entry %s: %w", entry.Sys.Id, err)
+		}
+
+		result[entry.Sys.Id] = jsontypes.NewNormalizedValue(string(fields))
+		versions[entry.Sys.Id] = types.Int64Value(entry.Sys.Version)
+
+		published := entryStatus(entry) == utils.StatusPublished
+		allPublished = allPublished && published
+		anyPublished = anyPublished || published
+	}
+
+	c.Entries = result
+	c.Versions = types.MapValueMust(types.Int64Type, versions)
+
+	// A collection that should be published reports drift when any entry is
+	// not published (anymore), one that should not be published when any
+	// entry is published
+	if c.Published.ValueBool() {
+		c.Published = types.BoolValue(allPublished)
+	} else {
+		c.Published = types.BoolValue(anyPublished)
+	}
+
+	return nil
+}
+
+func entryStatus(entry sdk.Entry) string {
+	return utils.PublishStatus(entry.Sys.Version, entry.Sys.PublishedVersion, entry.Sys.PublishedAt != nil, entry.Sys.ArchivedAt != nil)
+}
+
+// entryLink returns the link to the given version of an entry as used by bulk
+// actions
+func entryLink(id string, version *int64) sdk.VersionedLink {
+	return sdk.VersionedLink{
+		Sys: sdk.VersionedLinkSys{
+			Type:     "Link",
+			LinkType: "Entry",
+			Id:       id,
+			Version:  version,
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package entry_collection_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/entry_collection"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func parseEntries(t *testing.T, data string) map[string]sdk.Entry {
+	var items []sdk.Entry
+	err := json.Unmarshal([]byte(data), &items)
+	assert.NoError(t, err)
+
+	result := map[string]sdk.Entry{}
+	for _, item := range items {
+		result[item.Sys.Id] = item
+	}
+	return result
+}
+
+const testEntries = `[
+	{
+		"fields": {"name": {"nl-NL": "Nederland", "en-US": "Netherlands"}},
+		"sys": {"id": "nl", "version": 2, "publishedVersion": 1, "publishedAt": "2025-01-01T00:00:00Z"}
+	},
+	{
+		"fields": {"name": {"en-US": "Germany"}},
+		"sys": {"id": "de", "version": 1}
+	},
+	{
+		"fields": {"name": {"en-US": "Belgium"}},
+		"sys": {"id": "be", "version": 4, "publishedVersion": 3, "publishedAt": "2025-01-01T00:00:00Z"}
+	}
+]`
+
+func TestEntryCollectionImport(t *testing.T) {
+	entries := parseEntries(t, testEntries)
+
+	c := &entry_collection.EntryCollection{
+		Entries: map[string]jsontypes.Normalized{
+			"nl": jsontypes.NewNormalizedValue(`{}`),
+			"de": jsontypes.NewNormalizedValue(`{}`),
+		},
+		Published: types.BoolValue(true),
+	}
+
+	err := c.Import(entries, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"de", "nl"}, c.EntryIDs())
+	assert.Equal(t, `{"name":{"en-US":"Netherlands","nl-NL":"Nederland"}}`, c.Entries["nl"].ValueString())
+	assert.Equal(t, types.Int64Value(2), c.Versions.Elements()["nl"])
+
+	// Not all entries are published, which is reported as drift
+	assert.Equal(t, types.BoolValue(false), c.Published)
+
+	err = c.Import(entries, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"be", "de", "nl"}, c.EntryIDs())
+
+	// Some of the entries are published, which is reported as drift
+	assert.Equal(t, types.BoolValue(true), c.Published)
+}
+
+func TestEntryCollectionChanged(t *testing.T) {
+	entries := parseEntries(t, testEntries)
+	nl := entries["nl"]
+
+	c := &entry_collection.EntryCollection{
+		Entries: map[string]jsontypes.Normalized{
+			"nl": jsontypes.NewNormalizedValue(`{"name": {"en-US": "Netherlands", "nl-NL": "Nederland"}}`),
+		},
+	}
+
+	changed, err := c.Changed(context.Background(), "nl", &nl)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	c.Entries["nl"] = jsontypes.NewNormalizedValue(`{"name": {"en-US": "The Netherlands"}}`)
+	changed, err = c.Changed(context.Background(), "nl", &nl)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+
+	// Locales which are not configured are removed when writing the entry
+	c.Entries["nl"] = jsontypes.NewNormalizedValue(`{"name": {"en-US": "Netherlands"}}`)
+	changed, err = c.Changed(context.Background(), "nl", &nl)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+}
+
+func TestEntryCollectionDraft(t *testing.T) {
+	c := &entry_collection.EntryCollection{
+		Entries: map[string]jsontypes.Normalized{
+			"nl":      jsontypes.NewNormalizedValue(`{"name": {"en-US": "Netherlands"}}`),
+			"invalid": jsontypes.NewNormalizedValue(`["name"]`),
+		},
+	}
+
+	draft, err := c.Draft("nl")
+	assert.NoError(t, err)
+
+	data, err := json.Marshal(draft)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"fields": {"name": {"en-US": "Netherlands"}}}`, string(data))
+
+	_, err = c.Draft("invalid")
+	assert.Error(t, err)
+}
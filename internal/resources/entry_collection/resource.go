@@ -0,0 +1,498 @@
+package entry_collection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &entryCollectionResource{}
+	_ resource.ResourceWithConfigure   = &entryCollectionResource{}
+	_ resource.ResourceWithImportState = &entryCollectionResource{}
+)
+
+func NewEntryCollectionResource() resource.Resource {
+	return &entryCollectionResource{}
+}
+
+// entryCollectionResource is the resource implementation.
+type entryCollectionResource struct {
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
+}
+
+func (e *entryCollectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_entry_collection"
+}
+
+func (e *entryCollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "A collection of Contentful entries of a single content type, typically reference data which is " +
+			"read from a JSON or YAML file. The entries are written in batches and published with bulk actions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Collection ID, the ID of the content type",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"contenttype_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Content Type ID of the entries",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entries": schema.MapAttribute{
+				Required:    true,
+				ElementType: jsontypes.NormalizedType{},
+				Description: "The entries of the collection keyed by entry ID. Each entry is a JSON object with the fields " +
+					"of the entry, keyed by field ID and locale, e.g. `{\"name\": {\"en-US\": \"Netherlands\"}}`. The fields " +
+					"are authoritative, fields and locales which are not configured are removed from the entry. Entries " +
+					"which are removed from the collection are deleted.",
+			},
+			"published": schema.BoolAttribute{
+				Required:    true,
+				Description: "Whether the entries are published",
+			},
+			"adopt_existing": utils.AdoptExistingAttribute(),
+			"versions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The current version of the entries keyed by entry ID",
+			},
+		},
+	}
+}
+
+func (e *entryCollectionResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+	e.adoptExisting = data.AdoptExisting
+}
+
+func (e *entryCollectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Get plan values
+	var plan EntryCollection
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.ContentTypeID
+
+	// Nothing is written or stored in the state when existing entries can't
+	// be adopted
+	if !e.checkExisting(ctx, &plan, &response.Diagnostics) {
+		return
+	}
+
+	e.apply(ctx, &plan, nil, &response.Diagnostics)
+
+	// The entries which have been written are kept in the state, also when
+	// some of the entries failed
+	e.doRead(ctx, &plan, &response.State, &response.Diagnostics)
+}
+
+func (e *entryCollectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	// Get current state
+	var state EntryCollection
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	e.doRead(ctx, &state, &response.State, &response.Diagnostics)
+}
+
+func (e *entryCollectionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Get plan values
+	var plan EntryCollection
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state EntryCollection
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+
+	e.apply(ctx, &plan, &state, &response.Diagnostics)
+	e.doRead(ctx, &plan, &response.State, &response.Diagnostics)
+}
+
+func (e *entryCollectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Get current state
+	var state EntryCollection
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := e.listEntries(ctx, &state)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error deleting entry collection",
+			"Could not read entries: "+err.Error(),
+		)
+		return
+	}
+
+	e.deleteEntries(ctx, &state, state.EntryIDs(), current, &response.Diagnostics)
+}
+
+func (e *entryCollectionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts, err := utils.ParseThreePartID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing entry collection",
+			fmt.Sprintf("Expected import format: space_id:environment:contenttype_id, got: %s", request.ID),
+		)
+		return
+	}
+
+	collection := EntryCollection{
+		ID:            types.StringValue(idParts[2]),
+		SpaceID:       types.StringValue(idParts[0]),
+		Environment:   types.StringValue(idParts[1]),
+		ContentTypeID: types.StringValue(idParts[2]),
+	}
+
+	entries, err := e.listEntries(ctx, &collection)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing entry collection",
+			"Could not read entries: "+err.Error(),
+		)
+		return
+	}
+
+	// All entries of the content type are imported
+	if err := collection.Import(entries, true); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing entry collection",
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, collection)...)
+}
+
+func (e *entryCollectionResource) doRead(ctx context.Context, collection *EntryCollection, state *tfsdk.State, d *diag.Diagnostics) {
+	entries, err := e.listEntries(ctx, collection)
+	if err != nil {
+		d.AddError(
+			"Error reading entry collection",
+			"Could not read entries: "+err.Error(),
+		)
+		return
+	}
+
+	if err := collection.Import(entries, false); err != nil {
+		d.AddError(
+			"Error reading entry collection",
+			err.Error(),
+		)
+		return
+	}
+
+	d.Append(state.Set(ctx, collection)...)
+}
+
+// apply writes the entries of the plan, deletes the entries which were in the
+// previous state but are no longer planned and publishes or unpublishes the
+// entries
+func (e *entryCollectionResource) apply(ctx context.Context, plan *EntryCollection, previous *EntryCollection, d *diag.Diagnostics) {
+	current, err := e.listEntries(ctx, plan)
+	if err != nil {
+		d.AddError(
+			"Error updating entry collection",
+			"Could not read entries: "+err.Error(),
+		)
+		return
+	}
+
+	if previous != nil {
+		var removed []string
+		for _, id := range previous.EntryIDs() {
+			if _, ok := plan.Entries[id]; !ok {
+				removed = append(removed, id)
+			}
+		}
+
+		e.deleteEntries(ctx, plan, removed, current, d)
+		if d.HasError() {
+			return
+		}
+	}
+
+	// The entries are written and published per batch, so the entries of a
+	// batch are published before the next batch is written
+	for _, batch := range chunk(plan.EntryIDs(), bulkActionLimit) {
+		var entities []sdk.VersionedLink
+		var unpublish []sdk.VersionedLink
+
+		for _, id := range batch {
+			entry, err := e.writeEntry(ctx, plan, id, current)
+			if err != nil {
+				d.AddAttributeError(
+					path.Root("entries").AtMapKey(id),
+					"Error writing entry",
+					fmt.Sprintf("Could not write entry %s: %s", id, err.Error()),
+				)
+				continue
+			}
+
+			status := entryStatus(*entry)
+			if plan.Published.ValueBool() && status != utils.StatusPublished {
+				entities = append(entities, entryLink(id, utils.Pointer(entry.Sys.Version)))
+			}
+			if !plan.Published.ValueBool() && (status == utils.StatusPublished || status == utils.StatusChanged) {
+				unpublish = append(unpublish, entryLink(id, nil))
+			}
+		}
+
+		if d.HasError() {
+			return
+		}
+
+		if len(entities) > 0 {
+			if !e.validateEntries(ctx, plan, entities, d) {
+				return
+			}
+
+			resp, err := e.client.PublishBulkActionWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), bulkActionDraft(entities))
+			if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+				d.AddError(
+					"Error publishing entries",
+					"Could not publish entries: "+err.Error(),
+				)
+				return
+			}
+
+			if !e.waitForBulkAction(ctx, plan, resp.JSON201, "publish", d) {
+				return
+			}
+		}
+
+		if len(unpublish) > 0 {
+			if !e.unpublishEntries(ctx, plan, unpublish, d) {
+				return
+			}
+		}
+	}
+}
+
+// checkExisting checks whether entries of the collection already exist. These
+// are only overwritten when they are adopted, otherwise an error is reported
+// per entry.
+func (e *entryCollectionResource) checkExisting(ctx context.Context, plan *EntryCollection, d *diag.Diagnostics) bool {
+	current, err := e.listEntries(ctx, plan)
+	if err != nil {
+		d.AddError(
+			"Error creating entry collection",
+			"Could not read entries: "+err.Error(),
+		)
+		return false
+	}
+
+	adopt := utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting)
+	for _, id := range plan.EntryIDs() {
+		if _, ok := current[id]; !ok {
+			continue
+		}
+
+		if !adopt {
+			d.AddAttributeError(
+				path.Root("entries").AtMapKey(id),
+				"Error creating entry collection",
+				fmt.Sprintf("Entry %s already exists. Please import the collection, set adopt_existing to adopt it, or remove it before retrying.", id),
+			)
+			continue
+		}
+
+		utils.AddAdoptedWarning(d, "entry", id)
+	}
+
+	return !d.HasError()
+}
+
+// writeEntry creates the entry with the given id or updates it when it
+// differs from the configuration. The current version of the entry is
+// returned.
+func (e *entryCollectionResource) writeEntry(ctx context.Context, plan *EntryCollection, id string, current map[string]sdk.Entry) (*sdk.Entry, error) {
+	params := &sdk.UpdateEntryParams{
+		XContentfulContentType: plan.ContentTypeID.ValueString(),
+	}
+	expectedStatus := http.StatusCreated
+
+	if existing, ok := current[id]; ok {
+		changed, err := plan.Changed(ctx, id, &existing)
+		if err != nil {
+			return nil, err
+		}
+
+		if !changed {
+			return &existing, nil
+		}
+
+		params.XContentfulVersion = existing.Sys.Version
+		expectedStatus = http.StatusOK
+	}
+
+	draft, err := plan.Draft(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := e.client.UpdateEntryWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), id, params, draft)
+	if err := utils.CheckClientResponse(resp, err, expectedStatus); err != nil {
+		return nil, err
+	}
+
+	if expectedStatus == http.StatusCreated {
+		return resp.JSON201, nil
+	}
+	return resp.JSON200, nil
+}
+
+// deleteEntries unpublishes and deletes the entries with the given ids which
+// still exist. Unpublishing changes the version of the entries, so the entries
+// are read again before they are deleted.
+func (e *entryCollectionResource) deleteEntries(ctx context.Context, collection *EntryCollection, ids []string, current map[string]sdk.Entry, d *diag.Diagnostics) {
+	var published []string
+	for _, id := range ids {
+		if entry, ok := current[id]; ok && entry.Sys.PublishedAt != nil {
+			published = append(published, id)
+		}
+	}
+
+	if len(published) > 0 {
+		for _, batch := range chunk(published, bulkActionLimit) {
+			unpublish := make([]sdk.VersionedLink, 0, len(batch))
+			for _, id := range batch {
+				unpublish = append(unpublish, entryLink(id, nil))
+			}
+
+			if !e.unpublishEntries(ctx, collection, unpublish, d) {
+				return
+			}
+		}
+
+		var err error
+		current, err = e.listEntries(ctx, collection)
+		if err != nil {
+			d.AddError(
+				"Error deleting entries",
+				"Could not read entries: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, id := range ids {
+		entry, ok := current[id]
+		if !ok {
+			continue
+		}
+
+		resp, err := e.client.DeleteEntryWithResponse(
+			ctx,
+			collection.SpaceID.ValueString(),
+			collection.Environment.ValueString(),
+			id,
+			&sdk.DeleteEntryParams{
+				XContentfulVersion: entry.Sys.Version,
+			},
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+			if resp != nil && resp.StatusCode() == http.StatusNotFound {
+				continue
+			}
+
+			d.AddAttributeError(
+				path.Root("entries").AtMapKey(id),
+				"Error deleting entry",
+				fmt.Sprintf("Could not delete entry %s: %s", id, err.Error()),
+			)
+		}
+	}
+}
+
+// listEntries returns all entries of the content type of the collection keyed
+// by entry id
+func (e *entryCollectionResource) listEntries(ctx context.Context, collection *EntryCollection) (map[string]sdk.Entry, error) {
+	result := map[string]sdk.Entry{}
+	limit := 100
+
+	for skip := 0; ; skip += limit {
+		resp, err := e.client.GetAllEntriesWithResponse(
+			ctx,
+			collection.SpaceID.ValueString(),
+			collection.Environment.ValueString(),
+			&sdk.GetAllEntriesParams{
+				ContentType: utils.Pointer(collection.ContentTypeID.ValueString()),
+				Limit:       utils.Pointer(limit),
+				Skip:        utils.Pointer(skip),
+			},
+		)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200.Items == nil {
+			break
+		}
+
+		items := *resp.JSON200.Items
+		for _, item := range items {
+			result[item.Sys.Id] = item
+		}
+
+		if len(items) < limit {
+			break
+		}
+	}
+
+	return result, nil
+}
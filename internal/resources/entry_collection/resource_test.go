@@ -0,0 +1,222 @@
+package entry_collection_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestEntryCollectionResource_Basic(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry_collection.countries"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulEntryCollectionDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEntryCollectionConfig(spaceID, map[string]string{
+					"tf-test-nl": "Netherlands",
+					"tf-test-de": "Germany",
+				}, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tf_test_country"),
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "published", "true"),
+					testAccCheckContentfulCollectionEntry(t, resourceName, "tf-test-nl", func(t *testing.T, entry *sdk.Entry) {
+						assert.NotNil(t, entry.Sys.PublishedAt)
+					}),
+				),
+			},
+			{
+				Config: testEntryCollectionConfig(spaceID, map[string]string{
+					"tf-test-nl": "The Netherlands",
+					"tf-test-be": "Belgium",
+				}, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "published", "false"),
+					testAccCheckContentfulCollectionEntry(t, resourceName, "tf-test-nl", func(t *testing.T, entry *sdk.Entry) {
+						assert.Nil(t, entry.Sys.PublishedAt)
+						name, _ := entry.Fields.Get("name")
+						content, err := json.Marshal(name)
+						assert.NoError(t, err)
+						assert.JSONEq(t, `{"en-US": "The Netherlands"}`, string(content))
+					}),
+					testAccCheckContentfulCollectionEntryDeleted(resourceName, "tf-test-de"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:%s",
+						rs.Primary.Attributes["space_id"],
+						rs.Primary.Attributes["environment"],
+						rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestEntryCollectionResource_AdoptExisting(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry_collection.countries"
+	countries := map[string]string{"tf-test-fr": "France"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulEntryCollectionDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEntryCollectionAdoptConfig(spaceID, countries, false, false),
+			},
+			{
+				PreConfig: func() {
+					fields := orderedmap.New()
+					fields.Set("name", map[string]any{"en-US": "Existing France"})
+
+					client := acctest.GetClient()
+					resp, err := client.UpdateEntryWithResponse(context.Background(), spaceID, "master", "tf-test-fr", &sdk.UpdateEntryParams{
+						XContentfulContentType: "tf_test_country",
+					}, sdk.EntryDraft{Fields: fields})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating entry: %d", resp.StatusCode())
+					}
+				},
+				Config:      testEntryCollectionAdoptConfig(spaceID, countries, true, false),
+				ExpectError: regexp.MustCompile(`Entry tf-test-fr already exists`),
+			},
+			{
+				Config: testEntryCollectionAdoptConfig(spaceID, countries, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entries.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulCollectionEntry(t, resourceName, "tf-test-fr", func(t *testing.T, entry *sdk.Entry) {
+						assert.NotNil(t, entry.Sys.PublishedAt)
+						name, _ := entry.Fields.Get("name")
+						content, err := json.Marshal(name)
+						assert.NoError(t, err)
+						assert.JSONEq(t, `{"en-US": "France"}`, string(content))
+					}),
+				),
+			},
+		},
+	})
+}
+
+type assertFunc func(*testing.T, *sdk.Entry)
+
+func getCollectionEntry(s *terraform.State, resourceName string, id string) (*sdk.GetEntryResponse, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("entry collection not found in state: %s", resourceName)
+	}
+
+	client := acctest.GetClient()
+	return client.GetEntryWithResponse(context.Background(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["environment"], id)
+}
+
+func testAccCheckContentfulCollectionEntry(t *testing.T, resourceName string, id string, assertFunc assertFunc) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := getCollectionEntry(s, resourceName, id)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() != 200 {
+			return fmt.Errorf("entry not found: %s", id)
+		}
+
+		assertFunc(t, resp.JSON200)
+		return nil
+	}
+}
+
+func testAccCheckContentfulCollectionEntryDeleted(resourceName string, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := getCollectionEntry(s, resourceName, id)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() != 404 {
+			return fmt.Errorf("entry still exists with id: %s", id)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckContentfulEntryCollectionDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_entry_collection" {
+			continue
+		}
+
+		for _, id := range []string{"tf-test-nl", "tf-test-be"} {
+			resp, err := client.GetEntryWithResponse(context.Background(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["environment"], id)
+			if err != nil {
+				return err
+			}
+
+			if resp.StatusCode() != 404 {
+				return fmt.Errorf("entry still exists with id: %s", id)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testEntryCollectionConfig(spaceID string, countries map[string]string, published bool) string {
+	return utils.HCLTemplateFromPath("test_resources/create.tf", map[string]any{
+		"spaceId":    spaceID,
+		"countries":  countries,
+		"published":  published,
+		"collection": true,
+		"adopt":      false,
+	})
+}
+
+func testEntryCollectionAdoptConfig(spaceID string, countries map[string]string, collection bool, adopt bool) string {
+	return utils.HCLTemplateFromPath("test_resources/create.tf", map[string]any{
+		"spaceId":    spaceID,
+		"countries":  countries,
+		"published":  true,
+		"collection": collection,
+		"adopt":      adopt,
+	})
+}
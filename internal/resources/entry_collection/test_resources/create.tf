@@ -0,0 +1,45 @@
+resource "contentful_contenttype" "country" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  id            = "tf_test_country"
+  name          = "tf_test_country"
+  description   = "Terraform Acc Test Content Type"
+  display_field = "name"
+
+  field = {
+    name = {
+      position = 0
+      name     = "Name"
+      type     = "Symbol"
+      required = true
+    }
+  }
+}
+
+{{- if .collection }}
+
+locals {
+  countries = {
+  {{- range $id, $name := .countries }}
+    {{ $id }} = { name = "{{ $name }}" }
+  {{- end }}
+  }
+}
+
+resource "contentful_entry_collection" "countries" {
+  space_id       = "{{ .spaceId }}"
+  environment    = "master"
+  contenttype_id = contentful_contenttype.country.id
+
+  entries = {
+    for id, country in local.countries : id => jsonencode({
+      name = { "en-US" = country.name }
+    })
+  }
+
+  published = {{ .published }}
+  {{- if .adopt }}
+  adopt_existing = true
+  {{- end }}
+}
+{{- end }}
@@ -9,16 +9,17 @@ import (
 
 // Locale is the main resource schema data
 type Locale struct {
-	ID           types.String `tfsdk:"id"`
-	Version      types.Int64  `tfsdk:"version"`
-	SpaceID      types.String `tfsdk:"space_id"`
-	Environment  types.String `tfsdk:"environment"`
-	Name         types.String `tfsdk:"name"`
-	Code         types.String `tfsdk:"code"`
-	FallbackCode types.String `tfsdk:"fallback_code"`
-	Optional     types.Bool   `tfsdk:"optional"`
-	CDA          types.Bool   `tfsdk:"cda"`
-	CMA          types.Bool   `tfsdk:"cma"`
+	ID            types.String `tfsdk:"id"`
+	Version       types.Int64  `tfsdk:"version"`
+	SpaceID       types.String `tfsdk:"space_id"`
+	Environment   types.String `tfsdk:"environment"`
+	Name          types.String `tfsdk:"name"`
+	Code          types.String `tfsdk:"code"`
+	FallbackCode  types.String `tfsdk:"fallback_code"`
+	Optional      types.Bool   `tfsdk:"optional"`
+	CDA           types.Bool   `tfsdk:"cda"`
+	CMA           types.Bool   `tfsdk:"cma"`
+	AdoptExisting types.Bool   `tfsdk:"adopt_existing"`
 }
 
 // Import populates the Locale struct from an SDK locale object
@@ -28,7 +28,8 @@ func NewLocaleResource() resource.Resource {
 
 // localeResource is the resource implementation.
 type localeResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
 }
 
 func (e *localeResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -94,6 +95,7 @@ func (e *localeResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Whether this locale is available in the content management API",
 				Default:     booldefault.StaticBool(false),
 			},
+			"adopt_existing": utils.AdoptExistingAttribute(),
 		},
 	}
 }
@@ -105,6 +107,7 @@ func (e *localeResource) Configure(_ context.Context, request resource.Configure
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.adoptExisting = data.AdoptExisting
 }
 
 func (e *localeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -114,6 +117,44 @@ func (e *localeResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
+	// Locales are identified by their code, adopt the locale with the same
+	// code when it already exists
+	if utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+		existing, err := e.findLocaleByCode(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.Code.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error creating locale",
+				"Could not retrieve existing locales: "+err.Error(),
+			)
+			return
+		}
+
+		if existing != nil {
+			resp, err := e.client.UpdateLocaleWithResponse(
+				ctx,
+				plan.SpaceID.ValueString(),
+				plan.Environment.ValueString(),
+				existing.Sys.Id,
+				&sdk.UpdateLocaleParams{XContentfulVersion: existing.Sys.Version},
+				plan.DraftForUpdate(),
+			)
+			if err := utils.CheckClientResponse(resp, err, 200); err != nil {
+				response.Diagnostics.AddError(
+					"Error creating locale",
+					"Could not adopt locale: "+err.Error(),
+				)
+				return
+			}
+
+			utils.AddAdoptedWarning(&response.Diagnostics, "locale", plan.Code.ValueString())
+
+			state := &Locale{AdoptExisting: plan.AdoptExisting}
+			state.Import(resp.JSON200)
+			response.Diagnostics.Append(response.State.Set(ctx, state)...)
+			return
+		}
+	}
+
 	// Create the locale
 	draft := plan.DraftForCreate()
 
@@ -126,7 +167,7 @@ func (e *localeResource) Create(ctx context.Context, request resource.CreateRequ
 		return
 	}
 
-	state := &Locale{}
+	state := &Locale{AdoptExisting: plan.AdoptExisting}
 	state.Import(resp.JSON201)
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -200,6 +241,7 @@ func (e *localeResource) Update(ctx context.Context, request resource.UpdateRequ
 		return
 	}
 
+	state.AdoptExisting = plan.AdoptExisting
 	state.Import(resp.JSON200)
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -256,3 +298,32 @@ func (e *localeResource) ImportState(ctx context.Context, request resource.Impor
 	state.Import(resp.JSON200)
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
+
+// findLocaleByCode returns the locale with the given code, or nil when the
+// environment has no such locale
+func (e *localeResource) findLocaleByCode(ctx context.Context, spaceId string, environment string, code string) (*sdk.Locale, error) {
+	limit := 100
+	for skip := 0; ; skip += limit {
+		resp, err := e.client.GetAllLocalesWithResponse(ctx, spaceId, environment, &sdk.GetAllLocalesParams{
+			Limit: &limit,
+			Skip:  utils.Pointer(skip),
+		})
+		if err := utils.CheckClientResponse(resp, err, 200); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200.Items == nil {
+			return nil, nil
+		}
+
+		for _, locale := range *resp.JSON200.Items {
+			if locale.Code == code {
+				return &locale, nil
+			}
+		}
+
+		if len(*resp.JSON200.Items) < limit {
+			return nil, nil
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/labd/terraform-provider-contentful/internal/acctest"
 	"github.com/labd/terraform-provider-contentful/internal/provider"
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 type assertFunc func(*testing.T, *sdk.Locale)
@@ -89,6 +90,52 @@ func TestLocaleResource_Basic(t *testing.T) {
 	})
 }
 
+func TestLocaleResource_AdoptExisting(t *testing.T) {
+	name := fmt.Sprintf("locale-name-%s", hashicor_acctest.RandString(3))
+	code := fmt.Sprintf("l%s", hashicor_acctest.RandString(2))
+	resourceName := "contentful_locale.mylocale"
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	environment := "master"
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulLocaleDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					resp, err := client.CreateLocaleWithResponse(context.Background(), spaceID, environment, sdk.LocaleCreate{
+						Name:         "existing-" + name,
+						Code:         code,
+						FallbackCode: utils.Pointer("en-US"),
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating locale: %d", resp.StatusCode())
+					}
+					existingID = resp.JSON201.Sys.Id
+				},
+				Config: testLocaleAdoptConfig(spaceID, environment, name, code),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulLocaleExists(t, resourceName, func(t *testing.T, locale *sdk.Locale) {
+						assert.Equal(t, existingID, locale.Sys.Id)
+						assert.EqualValues(t, name, locale.Name)
+						assert.EqualValues(t, code, locale.Code)
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulLocaleExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		locale, err := getLocaleFromState(s, resourceName)
@@ -199,3 +246,19 @@ resource "contentful_locale" "mylocale" {
 }
 `, spaceID, environment, name, code)
 }
+
+func testLocaleAdoptConfig(spaceID, environment, name, code string) string {
+	return fmt.Sprintf(`
+resource "contentful_locale" "mylocale" {
+  space_id = "%s"
+  environment = "%s"
+  name = "%s"
+  code = "%s"
+  fallback_code = "en-US"
+  optional = false
+  cda = false
+  cma = true
+  adopt_existing = true
+}
+`, spaceID, environment, name, code)
+}
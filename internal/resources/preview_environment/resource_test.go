@@ -143,14 +143,14 @@ resource "contentful_contenttype" "example_contenttype" {
   description   = "content type description"
   display_field = "name"
 
-  fields = [
-    {
-      id       = "name"
+  field = {
+    name = {
+      position = 0
       name     = "Name"
       type     = "Text"
       required = true
     }
-  ]
+  }
 }
 	
 resource "contentful_preview_environment" "preview_environment" {
@@ -177,14 +177,14 @@ resource "contentful_contenttype" "example_contenttype" {
   description   = "content type description"
   display_field = "name"
 
-  fields = [
-    {
-      id       = "name"
+  field = {
+    name = {
+      position = 0
       name     = "Name"
       type     = "Text"
       required = true
     }
-  ]
+  }
 }	
 	
 resource "contentful_preview_environment" "preview_environment" {
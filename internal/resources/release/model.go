@@ -0,0 +1,88 @@
+package release
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// Release is the main resource schema data
+type Release struct {
+	ID          types.String `tfsdk:"id"`
+	Version     types.Int64  `tfsdk:"version"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	Environment types.String `tfsdk:"environment"`
+	Title       types.String `tfsdk:"title"`
+	Entities    Entities     `tfsdk:"entities"`
+	Publish     types.String `tfsdk:"publish"`
+}
+
+// Entities are the entries and assets of a release
+type Entities struct {
+	Entries []types.String `tfsdk:"entries"`
+	Assets  []types.String `tfsdk:"assets"`
+}
+
+// Import populates the Release struct from an SDK release object
+func (r *Release) Import(release *sdk.Release) {
+	r.ID = types.StringValue(release.Sys.Id)
+	r.Version = types.Int64Value(release.Sys.Version)
+	r.Title = types.StringValue(release.Title)
+
+	var entries, assets []types.String
+	for _, item := range release.Entities.Items {
+		switch item.Sys.LinkType {
+		case "Entry":
+			entries = append(entries, types.StringValue(item.Sys.Id))
+		case "Asset":
+			assets = append(assets, types.StringValue(item.Sys.Id))
+		}
+	}
+
+	// An empty list in the configuration is kept as is, instead of reading it
+	// as null
+	if len(entries) > 0 || r.Entities.Entries == nil {
+		r.Entities.Entries = entries
+	}
+	if len(assets) > 0 || r.Entities.Assets == nil {
+		r.Entities.Assets = assets
+	}
+}
+
+// Draft creates a ReleaseDraft object for creating or updating a release
+func (r *Release) Draft() sdk.ReleaseDraft {
+	draft := sdk.ReleaseDraft{
+		Title: r.Title.ValueString(),
+	}
+	draft.Entities.Sys.Type = "Array"
+	draft.Entities.Items = []sdk.VersionedLink{}
+
+	for _, id := range r.Entities.Entries {
+		draft.Entities.Items = append(draft.Entities.Items, link("Entry", id.ValueString()))
+	}
+	for _, id := range r.Entities.Assets {
+		draft.Entities.Items = append(draft.Entities.Items, link("Asset", id.ValueString()))
+	}
+
+	return draft
+}
+
+// ShouldPublish returns whether the release should be published, which is when
+// the publish trigger is set and differs from the trigger of the last publish
+func (r *Release) ShouldPublish(previous *Release) bool {
+	if r.Publish.IsNull() || r.Publish.IsUnknown() {
+		return false
+	}
+
+	return previous == nil || !r.Publish.Equal(previous.Publish)
+}
+
+func link(linkType string, id string) sdk.VersionedLink {
+	return sdk.VersionedLink{
+		Sys: sdk.VersionedLinkSys{
+			Type:     "Link",
+			LinkType: linkType,
+			Id:       id,
+		},
+	}
+}
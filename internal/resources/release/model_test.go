@@ -0,0 +1,73 @@
+package release_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/release"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func TestReleaseDraft(t *testing.T) {
+	r := &release.Release{
+		Title: types.StringValue("Summer campaign"),
+		Entities: release.Entities{
+			Entries: []types.String{types.StringValue("landing-page")},
+			Assets:  []types.String{types.StringValue("banner")},
+		},
+	}
+
+	data, err := json.Marshal(r.Draft())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"title": "Summer campaign",
+		"entities": {
+			"sys": {"type": "Array"},
+			"items": [
+				{"sys": {"type": "Link", "linkType": "Entry", "id": "landing-page"}},
+				{"sys": {"type": "Link", "linkType": "Asset", "id": "banner"}}
+			]
+		}
+	}`, string(data))
+}
+
+func TestReleaseImport(t *testing.T) {
+	result := &sdk.Release{}
+	err := json.Unmarshal([]byte(`{
+		"title": "Summer campaign",
+		"entities": {
+			"sys": {"type": "Array"},
+			"items": [{"sys": {"type": "Link", "linkType": "Entry", "id": "landing-page"}}]
+		},
+		"sys": {"id": "release", "type": "Release", "version": 3}
+	}`), result)
+	assert.NoError(t, err)
+
+	r := &release.Release{
+		Entities: release.Entities{
+			Assets: []types.String{},
+		},
+	}
+	r.Import(result)
+
+	assert.Equal(t, types.StringValue("release"), r.ID)
+	assert.Equal(t, types.Int64Value(3), r.Version)
+	assert.Equal(t, []types.String{types.StringValue("landing-page")}, r.Entities.Entries)
+
+	// The configured empty list is kept
+	assert.Equal(t, []types.String{}, r.Entities.Assets)
+}
+
+func TestReleaseShouldPublish(t *testing.T) {
+	r := &release.Release{Publish: types.StringNull()}
+	assert.False(t, r.ShouldPublish(nil))
+
+	r.Publish = types.StringValue("2026-06-21")
+	assert.True(t, r.ShouldPublish(nil))
+	assert.True(t, r.ShouldPublish(&release.Release{Publish: types.StringNull()}))
+	assert.False(t, r.ShouldPublish(&release.Release{Publish: types.StringValue("2026-06-21")}))
+	assert.True(t, r.ShouldPublish(&release.Release{Publish: types.StringValue("2026-01-01")}))
+}
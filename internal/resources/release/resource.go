@@ -0,0 +1,359 @@
+package release
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &releaseResource{}
+	_ resource.ResourceWithConfigure   = &releaseResource{}
+	_ resource.ResourceWithImportState = &releaseResource{}
+)
+
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+// releaseResource is the resource implementation.
+type releaseResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *releaseResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_release"
+}
+
+func (e *releaseResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "A Contentful Release groups entries and assets, so they can be published together.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Release ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current version of the release",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:    true,
+				Description: "Title of the release",
+			},
+			"entities": schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "The entries and assets of the release",
+				Attributes: map[string]schema.Attribute{
+					"entries": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "IDs of the entries of the release",
+					},
+					"assets": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						Description: "IDs of the assets of the release",
+					},
+				},
+			},
+			"publish": schema.StringAttribute{
+				Optional: true,
+				Description: "Trigger to publish the release. The release is published when the value is set or changed, " +
+					"e.g. to the date of a campaign launch. The apply waits until all entities of the release are " +
+					"published, or fails with the errors of the entities which could not be published.",
+			},
+		},
+	}
+}
+
+func (e *releaseResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *releaseResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Get plan values
+	var plan Release
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.CreateReleaseWithResponse(ctx, plan.SpaceID.ValueString(), plan.Environment.ValueString(), plan.Draft())
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError(
+			"Error creating release",
+			"Could not create release: "+err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.Import(resp.JSON201)
+
+	if plan.ShouldPublish(nil) {
+		// Store the created release first, so it is tracked when publishing
+		// fails. The failed create taints it and it is replaced on the next apply
+		state.Publish = types.StringNull()
+		response.Diagnostics.Append(response.State.Set(ctx, state)...)
+
+		if !e.publish(ctx, &state, &response.Diagnostics) {
+			return
+		}
+		state.Publish = plan.Publish
+	}
+
+	// Set state
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	// Get current state
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetReleaseWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error reading release",
+			"Could not read release: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Get plan values
+	var plan Release
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.UpdateReleaseWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.UpdateReleaseParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+		plan.Draft(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating release",
+			"Could not update release: "+err.Error(),
+		)
+		return
+	}
+
+	shouldPublish := plan.ShouldPublish(&state)
+	previousPublish := state.Publish
+
+	state = plan
+	state.Import(resp.JSON200)
+
+	if shouldPublish {
+		state.Publish = previousPublish
+		response.Diagnostics.Append(response.State.Set(ctx, state)...)
+
+		if !e.publish(ctx, &state, &response.Diagnostics) {
+			return
+		}
+	}
+	state.Publish = plan.Publish
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *releaseResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Get current state
+	var state Release
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Deleting a release keeps its entries and assets, also when the release
+	// has been published
+	resp, err := e.client.DeleteReleaseWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error deleting release",
+			"Could not delete release: "+err.Error(),
+		)
+	}
+}
+
+func (e *releaseResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts, err := utils.ParseThreePartID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing release",
+			fmt.Sprintf("Expected import format: space_id:environment:release_id, got: %s", request.ID),
+		)
+		return
+	}
+
+	resp, err := e.client.GetReleaseWithResponse(ctx, idParts[0], idParts[1], idParts[2])
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing release",
+			"Could not read release: "+err.Error(),
+		)
+		return
+	}
+
+	state := Release{
+		SpaceID:     types.StringValue(idParts[0]),
+		Environment: types.StringValue(idParts[1]),
+		Publish:     types.StringNull(),
+	}
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// publish starts publishing the release and waits until the release action
+// succeeded or failed. The errors of a failed release action are reported per
+// entity.
+func (e *releaseResource) publish(ctx context.Context, state *Release, d *diag.Diagnostics) bool {
+	resp, err := e.client.PublishReleaseWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.PublishReleaseParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusAccepted); err != nil {
+		d.AddError(
+			"Error publishing release",
+			"Could not publish release: "+err.Error(),
+		)
+		return false
+	}
+
+	action, err := e.waitForReleaseAction(ctx, state, resp.JSON202)
+	if err != nil {
+		d.AddError(
+			"Error publishing release",
+			"Could not publish release: "+err.Error(),
+		)
+		return false
+	}
+
+	if string(action.Sys.Status) == utils.ActionFailed {
+		addReleaseActionErrors(action, d)
+		return false
+	}
+
+	// Publishing updates the release, read the current version
+	release, err := e.client.GetReleaseWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(release, err, http.StatusOK); err != nil {
+		d.AddError(
+			"Error reading release",
+			"Could not read release: "+err.Error(),
+		)
+		return false
+	}
+	state.Import(release.JSON200)
+
+	return true
+}
+
+// waitForReleaseAction polls the release action until it succeeded or failed
+func (e *releaseResource) waitForReleaseAction(ctx context.Context, state *Release, action *sdk.ReleaseAction) (*sdk.ReleaseAction, error) {
+	return utils.WaitForAction(ctx, action, func(action *sdk.ReleaseAction) string {
+		return string(action.Sys.Status)
+	}, func() (*sdk.ReleaseAction, error) {
+		resp, err := e.client.GetReleaseActionWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString(), action.Sys.Id)
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+		return resp.JSON200, nil
+	})
+}
+
+// addReleaseActionErrors reports the errors of a failed release action, the
+// errors of an entity are reported with the type and ID of the entity
+func addReleaseActionErrors(action *sdk.ReleaseAction, d *diag.Diagnostics) {
+	summary := "Error publishing release"
+
+	for _, entityError := range utils.ActionErrors(action.Error) {
+		if entityError.Entity == nil {
+			d.AddError(summary, fmt.Sprintf("Release action %s failed: %s", action.Sys.Id, entityError.Message))
+			continue
+		}
+
+		attribute := "entries"
+		if entityError.Entity.Sys.LinkType == "Asset" {
+			attribute = "assets"
+		}
+
+		d.AddAttributeError(
+			path.Root("entities").AtName(attribute),
+			summary,
+			fmt.Sprintf("Could not publish %s %s: %s", entityError.Entity.Sys.LinkType, entityError.Entity.Sys.Id, entityError.Message),
+		)
+	}
+}
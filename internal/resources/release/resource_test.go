@@ -0,0 +1,108 @@
+package release_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestReleaseResource_Basic(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_release.campaign"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulReleaseDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testReleaseConfig(spaceID, "Summer campaign", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Summer campaign"),
+					resource.TestCheckResourceAttr(resourceName, "entities.entries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entities.entries.0", "tf-test-campaign"),
+					resource.TestCheckNoResourceAttr(resourceName, "publish"),
+					testAccCheckContentfulEntryPublished(t, false),
+				),
+			},
+			{
+				Config: testReleaseConfig(spaceID, "Summer campaign launch", "2026-06-21"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Summer campaign launch"),
+					resource.TestCheckResourceAttr(resourceName, "publish", "2026-06-21"),
+					testAccCheckContentfulEntryPublished(t, true),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"publish"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:%s",
+						rs.Primary.Attributes["space_id"],
+						rs.Primary.Attributes["environment"],
+						rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulEntryPublished(t *testing.T, published bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.GetClient()
+		resp, err := client.GetEntryWithResponse(context.Background(), os.Getenv("CONTENTFUL_SPACE_ID"), "master", "tf-test-campaign")
+		if err := utils.CheckClientResponse(resp, err, 200); err != nil {
+			return err
+		}
+
+		assert.Equal(t, published, resp.JSON200.Sys.PublishedAt != nil)
+		return nil
+	}
+}
+
+func testAccCheckContentfulReleaseDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_release" {
+			continue
+		}
+
+		resp, err := client.GetReleaseWithResponse(context.Background(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() != 404 {
+			return fmt.Errorf("release still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testReleaseConfig(spaceID string, title string, publish string) string {
+	return utils.HCLTemplateFromPath("test_resources/create.tf", map[string]any{
+		"spaceId": spaceID,
+		"title":   title,
+		"publish": publish,
+	})
+}
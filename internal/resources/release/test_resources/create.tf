@@ -0,0 +1,50 @@
+resource "contentful_contenttype" "campaign" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  id            = "tf_test_campaign"
+  name          = "tf_test_campaign"
+  description   = "Terraform Acc Test Content Type"
+  display_field = "title"
+
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      type     = "Symbol"
+      required = true
+    }
+  }
+}
+
+resource "contentful_entry" "campaign" {
+  entry_id       = "tf-test-campaign"
+  space_id       = "{{ .spaceId }}"
+  environment    = "master"
+  contenttype_id = contentful_contenttype.campaign.id
+  field {
+    id      = "title"
+    content = "Summer campaign"
+    locale  = "en-US"
+  }
+  published = false
+  archived  = false
+
+  # The entry is published by the release
+  lifecycle {
+    ignore_changes = [published]
+  }
+}
+
+resource "contentful_release" "campaign" {
+  space_id    = "{{ .spaceId }}"
+  environment = "master"
+  title       = "{{ .title }}"
+
+  entities = {
+    entries = [contentful_entry.campaign.entry_id]
+  }
+
+  {{- if .publish }}
+  publish = "{{ .publish }}"
+  {{- end }}
+}
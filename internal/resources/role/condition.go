@@ -0,0 +1,405 @@
+package role
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// conditionDepth is the number of levels of conditions, a condition can
+// contain `and` and `or` conditions up to this depth
+const conditionDepth = 3
+
+var (
+	conditionDocRegex  = regexp.MustCompile(`^(sys\.type|sys\.id|sys\.contentType\.sys\.id|metadata\.tags\.sys\.id|fields\.([A-Za-z0-9_]+|%)\.([A-Za-z0-9_-]+|%))$`)
+	conditionPathRegex = regexp.MustCompile(`^fields\.([A-Za-z0-9_]+|%)(\.([A-Za-z0-9_-]+|%))?$`)
+)
+
+var conditionOperators = []string{"equals", "in", "paths", "and", "or"}
+
+// conditionBlock returns the schema of the condition block of a policy
+func conditionBlock() schema.SingleNestedBlock {
+	attributes, blocks := conditionAttributes(conditionDepth)
+	return schema.SingleNestedBlock{
+		Description: fmt.Sprintf("The constraint of the policy. A condition uses exactly one of `equals`, `in`, "+
+			"`paths`, `and` or `or`, and can be negated with `not`. Conditions can be nested up to %d levels.", conditionDepth),
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+// conditionAttributes returns the schema of a condition at the given depth,
+// the deepest conditions cannot contain other conditions
+func conditionAttributes(depth int) (map[string]schema.Attribute, map[string]schema.Block) {
+	attributes := map[string]schema.Attribute{
+		"doc": schema.StringAttribute{
+			Optional: true,
+			Description: "The property to compare with `equals` or `in`, one of `sys.type`, `sys.id`, " +
+				"`sys.contentType.sys.id`, `metadata.tags.sys.id` or `fields.<id>.<locale>`",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(conditionDocRegex, "must be sys.type, sys.id, sys.contentType.sys.id, metadata.tags.sys.id or fields.<id>.<locale>"),
+			},
+		},
+		"equals": schema.StringAttribute{
+			Optional:    true,
+			Description: "The property needs to be equal to this value",
+		},
+		"in": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The property needs to be equal to one of these values",
+		},
+		"paths": schema.ListAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The fields the policy applies to, in the format `fields.<id>.<locale>`, where both the id " +
+				"and the locale can be `%` to match all",
+		},
+		"not": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Whether the condition is negated",
+		},
+	}
+
+	blocks := map[string]schema.Block{}
+	if depth > 1 {
+		nestedAttributes, nestedBlocks := conditionAttributes(depth - 1)
+		for _, operator := range []string{"and", "or"} {
+			blocks[operator] = schema.ListNestedBlock{
+				Description: fmt.Sprintf("Conditions of which %s need to match", map[string]string{"and": "all", "or": "at least one"}[operator]),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: nestedAttributes,
+					Blocks:     nestedBlocks,
+				},
+			}
+		}
+	}
+
+	return attributes, blocks
+}
+
+// conditionAttrTypes returns the attribute types of a condition at the given
+// depth
+func conditionAttrTypes(depth int) map[string]attr.Type {
+	attrTypes := map[string]attr.Type{
+		"doc":    types.StringType,
+		"equals": types.StringType,
+		"in":     types.ListType{ElemType: types.StringType},
+		"paths":  types.ListType{ElemType: types.StringType},
+		"not":    types.BoolType,
+	}
+
+	if depth > 1 {
+		nested := types.ObjectType{AttrTypes: conditionAttrTypes(depth - 1)}
+		attrTypes["and"] = types.ListType{ElemType: nested}
+		attrTypes["or"] = types.ListType{ElemType: nested}
+	}
+
+	return attrTypes
+}
+
+// conditionToSDK converts a condition to the constraint format of Contentful,
+// e.g. {"equals": [{"doc": "sys.type"}, "Entry"]}
+func conditionToSDK(condition types.Object) any {
+	attributes := condition.Attributes()
+	doc := map[string]any{"doc": attributes["doc"].(types.String).ValueString()}
+
+	var result map[string]any
+	switch {
+	case isSet(attributes["equals"]):
+		result = map[string]any{"equals": []any{doc, attributes["equals"].(types.String).ValueString()}}
+	case isSet(attributes["in"]):
+		result = map[string]any{"in": []any{doc, stringElements(attributes["in"].(types.List))}}
+	case isSet(attributes["paths"]):
+		var paths []any
+		for _, p := range stringElements(attributes["paths"].(types.List)) {
+			paths = append(paths, map[string]any{"doc": p})
+		}
+		result = map[string]any{"paths": paths}
+	default:
+		for _, operator := range []string{"and", "or"} {
+			if !isSet(attributes[operator]) {
+				continue
+			}
+
+			var conditions []any
+			for _, element := range attributes[operator].(types.List).Elements() {
+				conditions = append(conditions, conditionToSDK(element.(types.Object)))
+			}
+			result = map[string]any{operator: conditions}
+		}
+	}
+
+	if attributes["not"].(types.Bool).ValueBool() {
+		return map[string]any{"not": result}
+	}
+	return result
+}
+
+// conditionFromSDK converts a constraint of Contentful to a condition at the
+// given depth. It returns false when the constraint cannot be represented as
+// a condition.
+func conditionFromSDK(value any, depth int) (types.Object, bool) {
+	attrTypes := conditionAttrTypes(depth)
+	attributes := map[string]attr.Value{
+		"doc":    types.StringNull(),
+		"equals": types.StringNull(),
+		"in":     types.ListNull(types.StringType),
+		"paths":  types.ListNull(types.StringType),
+		"not":    types.BoolValue(false),
+	}
+	if depth > 1 {
+		attributes["and"] = types.ListValueMust(attrTypes["and"].(types.ListType).ElemType, []attr.Value{})
+		attributes["or"] = types.ListValueMust(attrTypes["or"].(types.ListType).ElemType, []attr.Value{})
+	}
+
+	constraint, ok := value.(map[string]any)
+	if !ok {
+		return types.ObjectNull(attrTypes), false
+	}
+
+	if negated, ok := constraint["not"].(map[string]any); ok && len(constraint) == 1 {
+		attributes["not"] = types.BoolValue(true)
+		constraint = negated
+	}
+
+	if len(constraint) != 1 {
+		return types.ObjectNull(attrTypes), false
+	}
+
+	for operator, raw := range constraint {
+		operands, ok := raw.([]any)
+		if !ok {
+			return types.ObjectNull(attrTypes), false
+		}
+
+		switch operator {
+		case "equals", "in":
+			if len(operands) != 2 {
+				return types.ObjectNull(attrTypes), false
+			}
+
+			doc, ok := docValue(operands[0])
+			if !ok {
+				return types.ObjectNull(attrTypes), false
+			}
+			attributes["doc"] = types.StringValue(doc)
+
+			if operator == "equals" {
+				equals, ok := operands[1].(string)
+				if !ok {
+					return types.ObjectNull(attrTypes), false
+				}
+				attributes["equals"] = types.StringValue(equals)
+			} else {
+				values, ok := operands[1].([]any)
+				if !ok {
+					return types.ObjectNull(attrTypes), false
+				}
+				list, ok := stringList(values, func(v any) (string, bool) { s, ok := v.(string); return s, ok })
+				if !ok {
+					return types.ObjectNull(attrTypes), false
+				}
+				attributes["in"] = list
+			}
+		case "paths":
+			list, ok := stringList(operands, docValue)
+			if !ok {
+				return types.ObjectNull(attrTypes), false
+			}
+			attributes["paths"] = list
+		case "and", "or":
+			if depth <= 1 {
+				return types.ObjectNull(attrTypes), false
+			}
+
+			var conditions []attr.Value
+			for _, operand := range operands {
+				condition, ok := conditionFromSDK(operand, depth-1)
+				if !ok {
+					return types.ObjectNull(attrTypes), false
+				}
+				conditions = append(conditions, condition)
+			}
+			attributes[operator] = types.ListValueMust(attrTypes[operator].(types.ListType).ElemType, conditions)
+		default:
+			return types.ObjectNull(attrTypes), false
+		}
+	}
+
+	return types.ObjectValueMust(attrTypes, attributes), true
+}
+
+// validateCondition checks that every condition has exactly one operator and
+// that the doc is only set for `equals` and `in`
+func validateCondition(condition types.Object, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if condition.IsNull() || condition.IsUnknown() {
+		return diags
+	}
+
+	attributes := condition.Attributes()
+
+	var operators []string
+	for _, operator := range conditionOperators {
+		if isSet(attributes[operator]) || (attributes[operator] != nil && attributes[operator].IsUnknown()) {
+			operators = append(operators, operator)
+		}
+	}
+
+	if len(operators) != 1 {
+		diags.AddAttributeError(
+			p,
+			"Invalid policy condition",
+			fmt.Sprintf("A condition needs exactly one of %s, got %d.", strings.Join(conditionOperators, ", "), len(operators)),
+		)
+		return diags
+	}
+
+	docSet := !attributes["doc"].IsNull()
+	if operator := operators[0]; (operator == "equals" || operator == "in") != docSet {
+		if docSet {
+			diags.AddAttributeError(p.AtName("doc"), "Invalid policy condition", fmt.Sprintf("The doc cannot be used with %s.", operator))
+		} else {
+			diags.AddAttributeError(p.AtName("doc"), "Invalid policy condition", fmt.Sprintf("The doc is required for %s.", operator))
+		}
+	}
+
+	if paths, ok := attributes["paths"].(types.List); ok && !paths.IsUnknown() {
+		for i, element := range paths.Elements() {
+			value, ok := element.(types.String)
+			if !ok || value.IsUnknown() || value.IsNull() {
+				continue
+			}
+			if !conditionPathRegex.MatchString(value.ValueString()) {
+				diags.AddAttributeError(
+					p.AtName("paths").AtListIndex(i),
+					"Invalid policy condition",
+					fmt.Sprintf("The path %q is not valid, paths need to be in the format fields.<id>.<locale>, where the id and locale can be %%.", value.ValueString()),
+				)
+			}
+		}
+	}
+
+	for _, operator := range []string{"and", "or"} {
+		conditions, ok := attributes[operator].(types.List)
+		if !ok || conditions.IsUnknown() {
+			continue
+		}
+		for i, element := range conditions.Elements() {
+			if nested, ok := element.(types.Object); ok {
+				diags.Append(validateCondition(nested, p.AtName(operator).AtListIndex(i))...)
+			}
+		}
+	}
+
+	return diags
+}
+
+// constraintReferences returns the content type IDs and field IDs which are
+// referenced in a constraint in the format of Contentful
+func constraintReferences(value any) (contentTypes []string, fields []string) {
+	switch v := value.(type) {
+	case map[string]any:
+		for operator, raw := range v {
+			operands, ok := raw.([]any)
+			if !ok {
+				contentTypes, fields = appendReferences(contentTypes, fields, raw)
+				continue
+			}
+
+			if (operator == "equals" || operator == "in") && len(operands) == 2 {
+				doc, _ := docValue(operands[0])
+				if doc == "sys.contentType.sys.id" {
+					switch ids := operands[1].(type) {
+					case string:
+						contentTypes = append(contentTypes, ids)
+					case []any:
+						for _, id := range ids {
+							if s, ok := id.(string); ok {
+								contentTypes = append(contentTypes, s)
+							}
+						}
+					}
+					continue
+				}
+			}
+
+			for _, operand := range operands {
+				if doc, ok := docValue(operand); ok {
+					if parts := strings.Split(doc, "."); len(parts) >= 2 && parts[0] == "fields" && parts[1] != "%" {
+						fields = append(fields, parts[1])
+					}
+					continue
+				}
+				contentTypes, fields = appendReferences(contentTypes, fields, operand)
+			}
+		}
+	case []any:
+		for _, item := range v {
+			contentTypes, fields = appendReferences(contentTypes, fields, item)
+		}
+	}
+
+	slices.Sort(contentTypes)
+	slices.Sort(fields)
+	return slices.Compact(contentTypes), slices.Compact(fields)
+}
+
+func appendReferences(contentTypes []string, fields []string, value any) ([]string, []string) {
+	c, f := constraintReferences(value)
+	return append(contentTypes, c...), append(fields, f...)
+}
+
+func docValue(value any) (string, bool) {
+	doc, ok := value.(map[string]any)
+	if !ok || len(doc) != 1 {
+		return "", false
+	}
+	s, ok := doc["doc"].(string)
+	return s, ok
+}
+
+func stringList(values []any, convert func(any) (string, bool)) (types.List, bool) {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		s, ok := convert(v)
+		if !ok {
+			return types.ListNull(types.StringType), false
+		}
+		elements = append(elements, types.StringValue(s))
+	}
+	return types.ListValueMust(types.StringType, elements), true
+}
+
+func stringElements(list types.List) []any {
+	var result []any
+	for _, element := range list.Elements() {
+		result = append(result, element.(types.String).ValueString())
+	}
+	return result
+}
+
+// isSet returns whether an attribute of a condition is configured. Absent
+// blocks are configured as an empty list, so empty lists are not set.
+func isSet(value attr.Value) bool {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	if list, ok := value.(types.List); ok {
+		return len(list.Elements()) > 0
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package role
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const testConstraint = `{"and": [
+	{"equals": [{"doc": "sys.type"}, "Entry"]},
+	{"or": [
+		{"in": [{"doc": "sys.contentType.sys.id"}, ["blogPost", "author"]]},
+		{"not": {"equals": [{"doc": "metadata.tags.sys.id"}, "internal"]}}
+	]},
+	{"paths": [{"doc": "fields.title.%"}, {"doc": "fields.body.en-US"}]}
+]}`
+
+func TestConditionRoundTrip(t *testing.T) {
+	var constraint any
+	assert.NoError(t, json.Unmarshal([]byte(testConstraint), &constraint))
+
+	condition, ok := conditionFromSDK(constraint, conditionDepth)
+	assert.True(t, ok)
+
+	and := condition.Attributes()["and"].(types.List).Elements()
+	assert.Len(t, and, 3)
+	assert.Equal(t, types.StringValue("sys.type"), and[0].(types.Object).Attributes()["doc"])
+	assert.Equal(t, types.StringValue("Entry"), and[0].(types.Object).Attributes()["equals"])
+
+	or := and[1].(types.Object).Attributes()["or"].(types.List).Elements()
+	assert.Equal(t, types.BoolValue(true), or[1].(types.Object).Attributes()["not"])
+
+	data, err := json.Marshal(conditionToSDK(condition))
+	assert.NoError(t, err)
+	assert.JSONEq(t, testConstraint, string(data))
+}
+
+func TestConditionFromSDKNotRepresentable(t *testing.T) {
+	for _, constraint := range []string{
+		// Nested deeper than the condition blocks
+		`{"and": [{"or": [{"and": [{"equals": [{"doc": "sys.type"}, "Entry"]}]}]}]}`,
+		`{"equals": [{"doc": "sys.type"}, "Entry"], "in": [{"doc": "sys.id"}, ["a"]]}`,
+		`{"and": [["equals", {"doc": "sys.type"}, "Entry"]]}`,
+		`{"not": {"not": {"equals": [{"doc": "sys.type"}, "Entry"]}}}`,
+	} {
+		var value any
+		assert.NoError(t, json.Unmarshal([]byte(constraint), &value))
+
+		_, ok := conditionFromSDK(value, conditionDepth)
+		assert.False(t, ok, constraint)
+	}
+}
+
+func TestValidateCondition(t *testing.T) {
+	condition := func(attributes map[string]attr.Value) types.Object {
+		values := map[string]attr.Value{
+			"doc":    types.StringNull(),
+			"equals": types.StringNull(),
+			"in":     types.ListNull(types.StringType),
+			"paths":  types.ListNull(types.StringType),
+			"not":    types.BoolValue(false),
+		}
+		for key, value := range attributes {
+			values[key] = value
+		}
+		return types.ObjectValueMust(conditionAttrTypes(1), values)
+	}
+
+	testCases := map[string]struct {
+		condition types.Object
+		valid     bool
+	}{
+		"equals": {
+			condition: condition(map[string]attr.Value{"doc": types.StringValue("sys.type"), "equals": types.StringValue("Entry")}),
+			valid:     true,
+		},
+		"paths": {
+			condition: condition(map[string]attr.Value{"paths": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("fields.%.%")})}),
+			valid:     true,
+		},
+		"no operator": {
+			condition: condition(map[string]attr.Value{"doc": types.StringValue("sys.type")}),
+		},
+		"two operators": {
+			condition: condition(map[string]attr.Value{
+				"doc":    types.StringValue("sys.type"),
+				"equals": types.StringValue("Entry"),
+				"in":     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Asset")}),
+			}),
+		},
+		"equals without doc": {
+			condition: condition(map[string]attr.Value{"equals": types.StringValue("Entry")}),
+		},
+		"paths with doc": {
+			condition: condition(map[string]attr.Value{
+				"doc":   types.StringValue("sys.type"),
+				"paths": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("fields.title.%")}),
+			}),
+		},
+		"invalid path": {
+			condition: condition(map[string]attr.Value{"paths": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("sys.id")})}),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			diags := validateCondition(tc.condition, path.Root("condition"))
+			assert.Equal(t, !tc.valid, diags.HasError())
+		})
+	}
+}
+
+func TestConstraintReferences(t *testing.T) {
+	var constraint any
+	assert.NoError(t, json.Unmarshal([]byte(testConstraint), &constraint))
+
+	contentTypes, fields := constraintReferences(constraint)
+
+	assert.Equal(t, []string{"author", "blogPost"}, contentTypes)
+	assert.Equal(t, []string{"body", "title"}, fields)
+}
+
+func TestRole_BuildPoliciesFromAPIResponse_Constraint(t *testing.T) {
+	var constraint any
+	assert.NoError(t, json.Unmarshal([]byte(`{"equals": [{"doc": "sys.type"}, "Entry"]}`), &constraint))
+
+	role := &sdk.Role{
+		Policies: &[]any{
+			map[string]any{"effect": "allow", "actions": "all", "constraint": constraint},
+		},
+	}
+
+	// Without previous policies the condition block is used
+	r := &Role{}
+	r.BuildPoliciesFromAPIResponse(role)
+	assert.False(t, r.Policy[0].Condition.IsNull())
+	assert.True(t, r.Policy[0].Constraint.IsNull())
+
+	// The JSON string is kept when it was used before
+	r.Policy[0] = Policy{Constraint: jsontypes.NewNormalizedValue(`{"equals": [{"doc": "sys.type"}, "Entry"]}`)}
+	r.BuildPoliciesFromAPIResponse(role)
+	assert.True(t, r.Policy[0].Condition.IsNull())
+	assert.Equal(t, jsontypes.NewNormalizedValue(`{"equals":[{"doc":"sys.type"},"Entry"]}`), r.Policy[0].Constraint)
+}
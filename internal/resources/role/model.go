@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/iancoleman/orderedmap"
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
@@ -21,6 +22,8 @@ type Role struct {
 	Description types.String `tfsdk:"description"`
 	Permission  []Permission `tfsdk:"permission"`
 	Policy      []Policy     `tfsdk:"policy"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
 }
 
 type Permission struct {
@@ -30,9 +33,10 @@ type Permission struct {
 }
 
 type Policy struct {
-	Effect     types.String `tfsdk:"effect"`
-	Actions    Action       `tfsdk:"actions"`
-	Constraint types.String `tfsdk:"constraint"`
+	Effect     types.String         `tfsdk:"effect"`
+	Actions    Action               `tfsdk:"actions"`
+	Constraint jsontypes.Normalized `tfsdk:"constraint"`
+	Condition  types.Object         `tfsdk:"condition"`
 }
 
 type Action struct {
@@ -116,7 +120,9 @@ func convertPolicies(policies []Policy) *[]any {
 			policyMap["actions"] = strVals
 		}
 
-		if c := policy.Constraint.ValueString(); c != "" {
+		if !policy.Condition.IsNull() && !policy.Condition.IsUnknown() {
+			policyMap["constraint"] = conditionToSDK(policy.Condition)
+		} else if c := policy.Constraint.ValueString(); c != "" {
 			policyMap["constraint"] = ParseContentValue(c)
 		}
 
@@ -182,20 +188,28 @@ func (r *Role) BuildPermissionsFromAPIResponse(role *sdk.Role) error {
 	return nil
 }
 
+// BuildPoliciesFromAPIResponse sets the policies of the role. The constraint
+// of a policy is set as a condition block when the previous policy at the same
+// position used a condition, or when there is no previous policy and the
+// constraint can be represented as a condition.
 func (r *Role) BuildPoliciesFromAPIResponse(role *sdk.Role) {
 	var policies []Policy
+	previous := r.Policy
 
 	if role.Policies == nil || len(*role.Policies) == 0 {
 		return
 	}
 
-	for _, raw := range *role.Policies {
+	for i, raw := range *role.Policies {
 		policyMap, ok := raw.(map[string]interface{})
 		if !ok {
 			continue
 		}
 
-		policy := Policy{}
+		policy := Policy{
+			Constraint: jsontypes.NewNormalizedNull(),
+			Condition:  types.ObjectNull(conditionAttrTypes(conditionDepth)),
+		}
 
 		// Handle "effect"
 		if effect, ok := policyMap["effect"].(string); ok {
@@ -218,10 +232,15 @@ func (r *Role) BuildPoliciesFromAPIResponse(role *sdk.Role) {
 			}
 		}
 
-		// Handle "constraint" as optional JSON string
+		// Handle "constraint" as condition block or optional JSON string
 		if constraintRaw, ok := policyMap["constraint"]; ok {
-			if marshaled, err := json.Marshal(constraintRaw); err == nil {
-				policy.Constraint = types.StringValue(string(marshaled))
+			useCondition := i >= len(previous) || !previous[i].Condition.IsNull()
+
+			condition, ok := conditionFromSDK(constraintRaw, conditionDepth)
+			if useCondition && ok {
+				policy.Condition = condition
+			} else if marshaled, err := json.Marshal(constraintRaw); err == nil {
+				policy.Constraint = jsontypes.NewNormalizedValue(string(marshaled))
 			}
 		}
 
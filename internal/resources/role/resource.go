@@ -2,14 +2,22 @@ package role
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
@@ -18,18 +26,27 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &roleResource{}
-	_ resource.ResourceWithConfigure   = &roleResource{}
-	_ resource.ResourceWithImportState = &roleResource{}
+	_ resource.Resource                   = &roleResource{}
+	_ resource.ResourceWithConfigure      = &roleResource{}
+	_ resource.ResourceWithImportState    = &roleResource{}
+	_ resource.ResourceWithValidateConfig = &roleResource{}
+	_ resource.ResourceWithModifyPlan     = &roleResource{}
 )
 
+// https://www.contentful.com/developers/docs/references/content-management-api/#/reference/roles
+var permissionIDs = []string{"ContentModel", "Settings", "ContentDelivery", "Environments", "EnvironmentAliases", "Tags"}
+var permissionValues = []string{"read", "manage", "all"}
+var policyActions = []string{"read", "create", "update", "delete", "publish", "unpublish", "archive", "unarchive", "all"}
+
 func NewRoleResource() resource.Resource {
 	return &roleResource{}
 }
 
 // roleResource is the resource implementation.
 type roleResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	environment   string
+	adoptExisting bool
 }
 
 func (e *roleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -74,6 +91,7 @@ func (e *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 				Description: "The description of the role",
 				Optional:    true,
 			},
+			"adopt_existing": utils.AdoptExistingAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"permission": schema.ListNestedBlock{
@@ -82,16 +100,26 @@ func (e *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 					Attributes: map[string]schema.Attribute{
 						"id": schema.StringAttribute{
 							Required:    true,
-							Description: "Permission ID",
+							Description: fmt.Sprintf("Permission ID, one of `%s`.", strings.Join(permissionIDs, "`, `")),
+							Validators: []validator.String{
+								stringvalidator.OneOf(permissionIDs...),
+							},
 						},
 						"value": schema.StringAttribute{
 							Optional:    true,
 							Description: "If all are allowed this should be `all`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(permissionValues...),
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("values")),
+							},
 						},
 						"values": schema.ListAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
-							Description: "List of permission values, e.g. [\"create\", \"read\"].",
+							Description: fmt.Sprintf("List of permission values, each one of `%s`.", strings.Join(permissionValues, "`, `")),
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(permissionValues...)),
+							},
 						},
 					},
 				},
@@ -102,7 +130,10 @@ func (e *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 					Attributes: map[string]schema.Attribute{
 						"effect": schema.StringAttribute{
 							Required:    true,
-							Description: "The effect of the policy (e.g., allow or deny).",
+							Description: "The effect of the policy, `allow` or `deny`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
 						},
 						"actions": schema.SingleNestedAttribute{
 							Required:    true,
@@ -110,26 +141,72 @@ func (e *roleResource) Schema(_ context.Context, _ resource.SchemaRequest, respo
 							Attributes: map[string]schema.Attribute{
 								"value": schema.StringAttribute{
 									Optional:    true,
-									Description: "Single action value (e.g., 'all').",
+									Description: fmt.Sprintf("Single action value, one of `%s`.", strings.Join(policyActions, "`, `")),
+									Validators: []validator.String{
+										stringvalidator.OneOf(policyActions...),
+										stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("values")),
+									},
 								},
 								"values": schema.ListAttribute{
 									ElementType: types.StringType,
 									Optional:    true,
-									Description: "List of action values (e.g., ['read', 'write']).",
+									Description: "List of action values, e.g. [\"read\", \"update\"].",
+									Validators: []validator.List{
+										listvalidator.ValueStringsAre(stringvalidator.OneOf(policyActions...)),
+									},
 								},
 							},
 						},
 						"constraint": schema.StringAttribute{
-							Optional:    true,
-							Description: "JSON-encoded constraint for the policy.",
+							Optional:   true,
+							CustomType: jsontypes.NormalizedType{},
+							Description: "JSON-encoded constraint for the policy. Use the `condition` block instead to " +
+								"write the constraint in HCL.",
 						},
 					},
+					Blocks: map[string]schema.Block{
+						"condition": conditionBlock(),
+					},
 				},
 			},
 		},
 	}
 }
 
+func (e *roleResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var policies types.List
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("policy"), &policies)...)
+	if response.Diagnostics.HasError() || policies.IsUnknown() {
+		return
+	}
+
+	for i, element := range policies.Elements() {
+		policy, ok := element.(types.Object)
+		if !ok || policy.IsUnknown() {
+			continue
+		}
+
+		p := path.Root("policy").AtListIndex(i)
+		constraint := policy.Attributes()["constraint"]
+		condition, _ := policy.Attributes()["condition"].(types.Object)
+
+		if constraint != nil && !constraint.IsNull() && !condition.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				p.AtName("condition"),
+				"Invalid policy",
+				"The constraint and the condition of a policy cannot both be set.",
+			)
+			continue
+		}
+
+		response.Diagnostics.Append(validateCondition(condition, p.AtName("condition"))...)
+	}
+}
+
+func (e *roleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	e.checkConstraintReferences(ctx, request, response)
+}
+
 func (e *roleResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -137,6 +214,8 @@ func (e *roleResource) Configure(_ context.Context, request resource.ConfigureRe
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.environment = data.Environment
+	e.adoptExisting = data.AdoptExisting
 }
 
 func (e *roleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -147,6 +226,50 @@ func (e *roleResource) Create(ctx context.Context, request resource.CreateReques
 		return
 	}
 
+	// Roles are identified by their name, adopt the role with the same name
+	// when exactly one such role exists
+	if utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+		existing, err := e.findRoleByName(ctx, plan.SpaceID.ValueString(), plan.Name.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error creating role",
+				"Could not adopt role: "+err.Error(),
+			)
+			return
+		}
+
+		if existing != nil {
+			resp, err := e.client.UpdateRoleWithResponse(
+				ctx,
+				plan.SpaceID.ValueString(),
+				existing.Sys.Id,
+				&sdk.UpdateRoleParams{XContentfulVersion: existing.Sys.Version},
+				plan.DraftForUpdate(),
+			)
+			if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+				response.Diagnostics.AddError(
+					"Error creating role",
+					"Could not adopt role: "+err.Error(),
+				)
+				return
+			}
+
+			utils.AddAdoptedWarning(&response.Diagnostics, "role", plan.Name.ValueString())
+
+			state := &Role{AdoptExisting: plan.AdoptExisting, Policy: plan.Policy}
+			if err := state.Import(resp.JSON200); err != nil {
+				response.Diagnostics.AddError(
+					"Error creating role",
+					"Could not parse response: "+err.Error(),
+				)
+				return
+			}
+
+			response.Diagnostics.Append(response.State.Set(ctx, state)...)
+			return
+		}
+	}
+
 	draft := plan.DraftForCreate()
 
 	resp, err := e.client.CreateRoleWithResponse(ctx, plan.SpaceID.ValueString(), draft)
@@ -158,7 +281,7 @@ func (e *roleResource) Create(ctx context.Context, request resource.CreateReques
 		return
 	}
 
-	state := &Role{}
+	state := &Role{AdoptExisting: plan.AdoptExisting, Policy: plan.Policy}
 	err = state.Import(resp.JSON201)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -239,6 +362,8 @@ func (e *roleResource) Update(ctx context.Context, request resource.UpdateReques
 		return
 	}
 
+	state.AdoptExisting = plan.AdoptExisting
+	state.Policy = plan.Policy
 	err = state.Import(resp.JSON200)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -354,3 +479,159 @@ func (e *roleResource) ImportState(ctx context.Context, request resource.ImportS
 
 	response.Diagnostics.Append(response.State.Set(ctx, role)...)
 }
+
+// findRoleByName returns the role with the given name, or nil when the
+// space has no such role. Names are not unique, an error is returned when
+// more than one role has the given name.
+func (e *roleResource) findRoleByName(ctx context.Context, spaceId string, name string) (*sdk.Role, error) {
+	var matches []sdk.Role
+
+	limit := 100
+	for skip := 0; ; skip += limit {
+		resp, err := e.client.GetAllRolesWithResponse(ctx, spaceId, &sdk.GetAllRolesParams{
+			Limit: &limit,
+			Skip:  utils.Pointer(skip),
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200.Items == nil {
+			break
+		}
+
+		for _, role := range *resp.JSON200.Items {
+			if role.Name == name {
+				matches = append(matches, role)
+			}
+		}
+
+		if len(*resp.JSON200.Items) < limit {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d roles are named %q, import the role to adopt it by id", len(matches), name)
+	}
+}
+
+// checkConstraintReferences warns about content types and fields which are
+// referenced in the constraints of the policies but do not exist in the
+// environment of the provider. These are warnings, as the content types can be
+// created in the same apply.
+func (e *roleResource) checkConstraintReferences(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if e.client == nil || request.Plan.Raw.IsNull() || request.Plan.Raw.Equal(request.State.Raw) {
+		return
+	}
+
+	var spaceID types.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+
+	var policies types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("policy"), &policies)...)
+
+	if response.Diagnostics.HasError() || spaceID.IsUnknown() || policies.IsUnknown() {
+		return
+	}
+
+	contentTypes := map[string]*sdk.ContentType{}
+	for i, element := range policies.Elements() {
+		constraint, p, ok := policyConstraint(ctx, element, path.Root("policy").AtListIndex(i))
+		if !ok {
+			continue
+		}
+
+		contentTypeIDs, fieldIDs := constraintReferences(constraint)
+
+		var existing []*sdk.ContentType
+		for _, id := range contentTypeIDs {
+			contentType, found := contentTypes[id]
+			if !found {
+				resp, err := e.client.GetContentTypeWithResponse(ctx, spaceID.ValueString(), e.environment, id)
+				if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil && (resp == nil || resp.StatusCode() != http.StatusNotFound) {
+					response.Diagnostics.AddError(
+						"Error validating role",
+						"Could not read content type: "+err.Error(),
+					)
+					return
+				}
+
+				if resp.StatusCode() == http.StatusOK {
+					contentType = resp.JSON200
+				}
+				contentTypes[id] = contentType
+			}
+
+			if contentType == nil {
+				response.Diagnostics.AddAttributeWarning(
+					p,
+					"Unknown content type in policy",
+					fmt.Sprintf("The content type %q does not exist in the %s environment, unless it is created in the same apply the policy has no effect.", id, e.environment),
+				)
+				continue
+			}
+			existing = append(existing, contentType)
+		}
+
+		// Fields can only be checked when the content types are known
+		if len(existing) == 0 {
+			continue
+		}
+
+		for _, id := range fieldIDs {
+			found := slices.ContainsFunc(existing, func(contentType *sdk.ContentType) bool {
+				return slices.ContainsFunc(contentType.Fields, func(field sdk.Field) bool { return field.Id == id })
+			})
+
+			if !found {
+				response.Diagnostics.AddAttributeWarning(
+					p,
+					"Unknown field in policy",
+					fmt.Sprintf("The field %q does not exist in the content types of the policy, unless it is created in the same apply the policy has no effect.", id),
+				)
+			}
+		}
+	}
+}
+
+// policyConstraint returns the constraint of a planned policy in the format of
+// Contentful and the path of the attribute which defines it. It returns false
+// when the policy has no constraint or when it is not known yet.
+func policyConstraint(ctx context.Context, element attr.Value, p path.Path) (any, path.Path, bool) {
+	policy, ok := element.(types.Object)
+	if !ok || policy.IsNull() || policy.IsUnknown() {
+		return nil, p, false
+	}
+
+	if condition, ok := policy.Attributes()["condition"].(types.Object); ok && !condition.IsNull() {
+		value, err := condition.ToTerraformValue(ctx)
+		if err != nil || !value.IsFullyKnown() {
+			return nil, p, false
+		}
+
+		// The constraint is converted to JSON, so it has the same types as a
+		// constraint which is set as a string
+		var constraint any
+		data, err := json.Marshal(conditionToSDK(condition))
+		if err != nil || json.Unmarshal(data, &constraint) != nil {
+			return nil, p, false
+		}
+		return constraint, p.AtName("condition"), true
+	}
+
+	if constraint, ok := policy.Attributes()["constraint"].(jsontypes.Normalized); ok && !constraint.IsNull() && !constraint.IsUnknown() {
+		var value any
+		if err := json.Unmarshal([]byte(constraint.ValueString()), &value); err != nil {
+			return nil, p, false
+		}
+		return value, p.AtName("constraint"), true
+	}
+
+	return nil, p, false
+}
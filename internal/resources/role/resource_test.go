@@ -2,14 +2,18 @@ package role_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	hashicoracctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/iancoleman/orderedmap"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/labd/terraform-provider-contentful/internal/acctest"
@@ -46,6 +50,22 @@ func TestRoleResource_Basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testEntryConditionConfig(spaceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContentfulRoleExists(t, resourceName, func(t *testing.T, role *sdk.Role) {
+						assert.Equal(t, "custom-role-name", role.Name)
+						assert.Equal(t, spaceID, role.Sys.Space.Sys.Id)
+
+						constraint, err := json.Marshal((*role.Policies)[0].(map[string]any)["constraint"])
+						assert.NoError(t, err)
+						assert.JSONEq(t, `{"and": [
+							{"equals": [{"doc": "sys.type"}, "Entry"]},
+							{"not": {"paths": [{"doc": "fields.internalNotes.%"}]}}
+						]}`, string(constraint))
+					}),
+				),
+			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
@@ -66,6 +86,115 @@ func TestRoleResource_Basic(t *testing.T) {
 	})
 }
 
+func TestRoleResource_Invalid(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulRoleDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRoleInvalidConfig(spaceID, `id = "ContentModels"`, `effect = "allow"`, `value = "all"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`got: "ContentModels"`),
+			},
+			{
+				Config:      testRoleInvalidConfig(spaceID, `id = "ContentModel"`, `effect = "allow"`, `values = ["read", "write"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`got: "write"`),
+			},
+			{
+				Config:      testRoleInvalidConfig(spaceID, `id = "ContentModel"`, `effect = "permit"`, `value = "all"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`got: "permit"`),
+			},
+			{
+				Config:      testRoleInvalidConfig(spaceID, `id = "ContentModel"`, `effect = "allow"`, `value = "all"`+"\n"+`values = ["read"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestRoleResource_AdoptExisting(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_role.example_role"
+	name := fmt.Sprintf("[automated] Adopted Role %s", hashicoracctest.RandString(3))
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulRoleDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					existingID, _ = createRole(t, spaceID, name)
+				},
+				Config: testRoleAdoptConfig(spaceID, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulRoleExists(t, resourceName, func(t *testing.T, role *sdk.Role) {
+						assert.Equal(t, existingID, role.Sys.Id)
+						assert.Equal(t, "Adopted Role Description", role.Description)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestRoleResource_AdoptExistingDuplicateName(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	name := fmt.Sprintf("[automated] Duplicate Role %s", hashicoracctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulRoleDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					for i := 0; i < 2; i++ {
+						id, version := createRole(t, spaceID, name)
+						t.Cleanup(func() {
+							_, _ = client.DeleteRoleWithResponse(context.Background(), spaceID, id, &sdk.DeleteRoleParams{XContentfulVersion: version})
+						})
+					}
+				},
+				Config:      testRoleAdoptConfig(spaceID, name),
+				ExpectError: regexp.MustCompile(`2 roles are named`),
+			},
+		},
+	})
+}
+
+// createRole creates a role outside of Terraform and returns its id and version
+func createRole(t *testing.T, spaceID string, name string) (string, int64) {
+	resp, err := acctest.GetClient().CreateRoleWithResponse(context.Background(), spaceID, sdk.RoleCreate{
+		Name:        name,
+		Description: "Existing Role Description",
+		Permissions: orderedmap.New(),
+		Policies:    &sdk.RolePolicies{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode() != 201 {
+		t.Fatalf("unexpected status code creating role: %d", resp.StatusCode())
+	}
+	return resp.JSON201.Sys.Id, resp.JSON201.Sys.Version
+}
+
 type assertFunc func(*testing.T, *sdk.Role)
 
 func testAccCheckContentfulRoleExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
@@ -221,3 +350,92 @@ resource "contentful_role" "example_role" {
 }
 `, spaceID)
 }
+
+func testEntryConditionConfig(spaceID string) string {
+	return fmt.Sprintf(`
+resource "contentful_role" "example_role" {
+  space_id = "%s"
+
+
+  name        = "custom-role-name"
+  description = "Custom Role Description"
+
+  permission {
+    id     = "ContentModel"
+    values = ["read"]
+  }
+
+  policy {
+    effect = "allow"
+    actions = {
+    	values = [
+			"read",
+			"create",
+			"update",
+			"delete",
+			"publish",
+			"unpublish",
+			"archive",
+			"unarchive"
+		]
+    }
+
+    condition {
+      and {
+        doc    = "sys.type"
+        equals = "Entry"
+      }
+
+      and {
+        not   = true
+        paths = ["fields.internalNotes.%%"]
+      }
+    }
+  }
+}
+`, spaceID)
+}
+
+func testRoleAdoptConfig(spaceID string, name string) string {
+	return fmt.Sprintf(`
+resource "contentful_role" "example_role" {
+  space_id       = "%s"
+  name           = "%s"
+  description    = "Adopted Role Description"
+  adopt_existing = true
+
+  permission {
+    id     = "ContentModel"
+    values = ["read"]
+  }
+
+  policy {
+    effect = "allow"
+    actions = {
+      values = ["read"]
+    }
+  }
+}
+`, spaceID, name)
+}
+
+func testRoleInvalidConfig(spaceID string, permissionID string, effect string, actions string) string {
+	return fmt.Sprintf(`
+resource "contentful_role" "example_role" {
+  space_id = "%s"
+  name     = "[automated] Invalid Role"
+
+  permission {
+    %s
+    values = ["read"]
+  }
+
+  policy {
+    %s
+    actions = {
+      %s
+    }
+  }
+}
+`, spaceID, permissionID, effect, actions)
+}
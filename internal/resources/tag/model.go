@@ -0,0 +1,39 @@
+package tag
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// Tag is the main resource schema data
+type Tag struct {
+	ID          types.String `tfsdk:"id"`
+	TagID       types.String `tfsdk:"tag_id"`
+	Version     types.Int64  `tfsdk:"version"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	Environment types.String `tfsdk:"environment"`
+	Name        types.String `tfsdk:"name"`
+	Visibility  types.String `tfsdk:"visibility"`
+}
+
+// Import populates the Tag struct from an SDK tag object
+func (t *Tag) Import(tag *sdk.Tag) {
+	t.ID = types.StringValue(tag.Sys.Id)
+	t.TagID = types.StringValue(tag.Sys.Id)
+	t.Version = types.Int64Value(tag.Sys.Version)
+	t.Name = types.StringValue(tag.Name)
+	t.Visibility = types.StringValue(tag.Sys.Visibility)
+}
+
+// Draft creates a TagDraft object for creating or updating a tag
+func (t *Tag) Draft() sdk.TagDraft {
+	draft := sdk.TagDraft{
+		Name: t.Name.ValueString(),
+	}
+	draft.Sys.Id = t.TagID.ValueString()
+	draft.Sys.Type = "Tag"
+	draft.Sys.Visibility = t.Visibility.ValueString()
+
+	return draft
+}
@@ -0,0 +1,260 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagResource{}
+	_ resource.ResourceWithConfigure   = &tagResource{}
+	_ resource.ResourceWithImportState = &tagResource{}
+)
+
+func NewTagResource() resource.Resource {
+	return &tagResource{}
+}
+
+// tagResource is the resource implementation.
+type tagResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *tagResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_tag"
+}
+
+func (e *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "A Contentful Tag is used to group entries and assets in an environment, e.g. for content governance or to scope roles.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Tag ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Tag identifier, which is used to tag entries and assets",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current version of the tag",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Required:    true,
+				Description: "Environment ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the tag",
+			},
+			"visibility": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Visibility of the tag, one of `private` or `public`. Public tags are also returned by the " +
+					"Content Delivery API. The visibility of a tag can not be changed, changing it recreates the tag.",
+				Default: stringdefault.StaticString("private"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("private", "public"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (e *tagResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *tagResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	// Get plan values
+	var plan Tag
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags are created with a PUT to the tag id
+	resp, err := e.client.UpdateTagWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		plan.TagID.ValueString(),
+		&sdk.UpdateTagParams{},
+		plan.Draft(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusCreated); err != nil {
+		response.Diagnostics.AddError(
+			"Error creating tag",
+			"Could not create tag: "+err.Error(),
+		)
+		return
+	}
+
+	state := plan
+	state.Import(resp.JSON201)
+
+	// Set state
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *tagResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	// Get current state
+	var state Tag
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetTagWithResponse(ctx, state.SpaceID.ValueString(), state.Environment.ValueString(), state.ID.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error reading tag",
+			"Could not read tag: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *tagResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// Get plan values
+	var plan Tag
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state Tag
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.UpdateTagWithResponse(
+		ctx,
+		plan.SpaceID.ValueString(),
+		plan.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.UpdateTagParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+		plan.Draft(),
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating tag",
+			"Could not update tag: "+err.Error(),
+		)
+		return
+	}
+
+	state = plan
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *tagResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	// Get current state
+	var state Tag
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteTagWithResponse(
+		ctx,
+		state.SpaceID.ValueString(),
+		state.Environment.ValueString(),
+		state.ID.ValueString(),
+		&sdk.DeleteTagParams{
+			XContentfulVersion: state.Version.ValueInt64(),
+		},
+	)
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error deleting tag",
+			"Could not delete tag: "+err.Error(),
+		)
+	}
+}
+
+func (e *tagResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts, err := utils.ParseThreePartID(request.ID)
+	if err != nil {
+		response.Diagnostics.AddError(
+			"Error importing tag",
+			fmt.Sprintf("Expected import format: space_id:environment:tag_id, got: %s", request.ID),
+		)
+		return
+	}
+
+	resp, err := e.client.GetTagWithResponse(ctx, idParts[0], idParts[1], idParts[2])
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing tag",
+			"Could not read tag: "+err.Error(),
+		)
+		return
+	}
+
+	state := Tag{
+		SpaceID:     types.StringValue(idParts[0]),
+		Environment: types.StringValue(idParts[1]),
+	}
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
@@ -0,0 +1,162 @@
+package tag_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+func TestTagResource_Basic(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_tag.governance"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulTagDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testTagConfig(spaceID, "Governance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "tfTestGovernance"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Governance"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "public"),
+					resource.TestCheckResourceAttr("contentful_entry.tagged", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("contentful_entry.tagged", "tags.*", "tfTestGovernance"),
+				),
+			},
+			{
+				Config: testTagConfig(spaceID, "Content governance"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "Content governance"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("not found: %s", resourceName)
+					}
+					return fmt.Sprintf("%s:%s:%s",
+						rs.Primary.Attributes["space_id"],
+						rs.Primary.Attributes["environment"],
+						rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func TestTagResource_UnknownTag(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "contentful_entry" "tagged" {
+  entry_id       = "tf-test-unknown-tag"
+  space_id       = "%s"
+  environment    = "master"
+  contenttype_id = "tf_test_tagged"
+  field {
+    id      = "title"
+    content = "Tagged entry"
+    locale  = "en-US"
+  }
+  tags      = ["tfTestDoesNotExist"]
+  published = false
+  archived  = false
+}
+`, spaceID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Tag not found"),
+			},
+		},
+	})
+}
+
+func TestTagResource_DefaultTags(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_entry.tagged"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulTagDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDefaultTagsConfig(spaceID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "0"),
+				),
+			},
+			{
+				// The default tag must exist before it is used
+				Config: testDefaultTagsConfig(spaceID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "tags_all.*", "tfTestManagedByTerraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulTagDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_tag" {
+			continue
+		}
+
+		resp, err := client.GetTagWithResponse(context.Background(), rs.Primary.Attributes["space_id"], rs.Primary.Attributes["environment"], rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() != 404 {
+			return fmt.Errorf("tag still exists with id: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testTagConfig(spaceID string, name string) string {
+	return utils.HCLTemplateFromPath("test_resources/create.tf", map[string]any{
+		"spaceId": spaceID,
+		"name":    name,
+	})
+}
+
+func testDefaultTagsConfig(spaceID string, defaultTags bool) string {
+	return utils.HCLTemplateFromPath("test_resources/default_tags.tf", map[string]any{
+		"spaceId":     spaceID,
+		"defaultTags": defaultTags,
+	})
+}
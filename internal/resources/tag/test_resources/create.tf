@@ -0,0 +1,40 @@
+resource "contentful_tag" "governance" {
+  space_id    = "{{ .spaceId }}"
+  environment = "master"
+  tag_id      = "tfTestGovernance"
+  name        = "{{ .name }}"
+  visibility  = "public"
+}
+
+resource "contentful_contenttype" "tagged" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  id            = "tf_test_tagged"
+  name          = "tf_test_tagged"
+  description   = "Terraform Acc Test Content Type"
+  display_field = "title"
+
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      type     = "Symbol"
+      required = true
+    }
+  }
+}
+
+resource "contentful_entry" "tagged" {
+  entry_id       = "tf-test-tagged"
+  space_id       = "{{ .spaceId }}"
+  environment    = "master"
+  contenttype_id = contentful_contenttype.tagged.id
+  field {
+    id      = "title"
+    content = "Tagged entry"
+    locale  = "en-US"
+  }
+  tags      = [contentful_tag.governance.id]
+  published = false
+  archived  = false
+}
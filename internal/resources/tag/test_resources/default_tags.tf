@@ -0,0 +1,44 @@
+provider "contentful" {
+  {{- if .defaultTags }}
+  default_tags = ["tfTestManagedByTerraform"]
+  {{- end }}
+}
+
+resource "contentful_tag" "managed" {
+  space_id    = "{{ .spaceId }}"
+  environment = "master"
+  tag_id      = "tfTestManagedByTerraform"
+  name        = "Managed by Terraform"
+}
+
+resource "contentful_contenttype" "tagged" {
+  space_id      = "{{ .spaceId }}"
+  environment   = "master"
+  id            = "tf_test_tagged"
+  name          = "tf_test_tagged"
+  description   = "Terraform Acc Test Content Type"
+  display_field = "title"
+
+  field = {
+    title = {
+      position = 0
+      name     = "Title"
+      type     = "Symbol"
+      required = true
+    }
+  }
+}
+
+resource "contentful_entry" "tagged" {
+  entry_id       = "tf-test-default-tags"
+  space_id       = "{{ .spaceId }}"
+  environment    = "master"
+  contenttype_id = contentful_contenttype.tagged.id
+  field {
+    id      = "title"
+    content = "Tagged entry"
+    locale  = "en-US"
+  }
+  published = false
+  archived  = false
+}
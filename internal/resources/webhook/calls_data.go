@@ -0,0 +1,119 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookCallsDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookCallsDataSource{}
+)
+
+func NewWebhookCallsDataSource() datasource.DataSource {
+	return &webhookCallsDataSource{}
+}
+
+// webhookCallsDataSource is the datasource implementation.
+type webhookCallsDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *webhookCallsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_webhook_calls"
+}
+
+func (e *webhookCallsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "The most recent calls of a webhook, with the response status and errors of each call.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhook ID",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+			},
+			"webhook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Webhook ID",
+			},
+			"calls": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The most recent calls of the webhook",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Call ID",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "URL which was called",
+						},
+						"event_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The action which triggered the call, e.g. `publish`",
+						},
+						"status_code": schema.Int64Attribute{
+							Computed:    true,
+							Description: "HTTP status code of the response, 0 when no response was received",
+						},
+						"errors": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Errors which occurred while calling the webhook",
+						},
+						"request_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the request was sent, in RFC 3339 format",
+						},
+						"response_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the response was received, in RFC 3339 format",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *webhookCallsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *webhookCallsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	data := &WebhookCallsData{}
+	response.Diagnostics.Append(request.Config.Get(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetWebhookCallsWithResponse(ctx, data.SpaceId.ValueString(), data.WebhookId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error reading webhook calls",
+			"Could not read webhook calls: "+err.Error(),
+		)
+		return
+	}
+
+	data.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
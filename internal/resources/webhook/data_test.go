@@ -0,0 +1,60 @@
+package webhook_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	hashicoracctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestWebhookDataSources(t *testing.T) {
+	name := fmt.Sprintf("webhook-name-%s", hashicoracctest.RandString(3))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulWebhookDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testWebhookDataSources(os.Getenv("CONTENTFUL_SPACE_ID"), name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.contentful_webhook_health.health", "id", "contentful_webhook.mywebhook", "id"),
+					resource.TestCheckResourceAttrSet("data.contentful_webhook_health.health", "total_calls"),
+					resource.TestCheckResourceAttrSet("data.contentful_webhook_health.health", "healthy_calls"),
+					resource.TestCheckResourceAttrPair("data.contentful_webhook_calls.calls", "id", "contentful_webhook.mywebhook", "id"),
+					resource.TestCheckResourceAttrSet("data.contentful_webhook_calls.calls", "calls.#"),
+				),
+			},
+		},
+	})
+}
+
+func testWebhookDataSources(spaceId string, name string) string {
+	return fmt.Sprintf(`
+resource "contentful_webhook" "mywebhook" {
+  space_id = "%s"
+  name     = "%s"
+  url      = "https://www.example.com/test"
+  topics   = ["Entry.publish"]
+}
+
+data "contentful_webhook_health" "health" {
+  space_id   = contentful_webhook.mywebhook.space_id
+  webhook_id = contentful_webhook.mywebhook.id
+}
+
+data "contentful_webhook_calls" "calls" {
+  space_id   = contentful_webhook.mywebhook.space_id
+  webhook_id = contentful_webhook.mywebhook.id
+}
+`, spaceId, name)
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookHealthDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookHealthDataSource{}
+)
+
+func NewWebhookHealthDataSource() datasource.DataSource {
+	return &webhookHealthDataSource{}
+}
+
+// webhookHealthDataSource is the datasource implementation.
+type webhookHealthDataSource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *webhookHealthDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_webhook_health"
+}
+
+func (e *webhookHealthDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "The health of a webhook, based on the responses of its most recent calls.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Webhook ID",
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+			},
+			"webhook_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Webhook ID",
+			},
+			"total_calls": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of recent calls of the webhook",
+			},
+			"healthy_calls": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of recent calls which received a successful response",
+			},
+		},
+	}
+}
+
+func (e *webhookHealthDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *webhookHealthDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	data := &WebhookHealthData{}
+	response.Diagnostics.Append(request.Config.Get(ctx, data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetWebhookHealthWithResponse(ctx, data.SpaceId.ValueString(), data.WebhookId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error reading webhook health",
+			"Could not read webhook health: "+err.Error(),
+		)
+		return
+	}
+
+	data.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
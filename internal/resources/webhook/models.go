@@ -3,8 +3,11 @@ package webhook
 import (
 	"encoding/json"
 	"errors"
+	"slices"
+	"time"
 
 	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
@@ -13,17 +16,41 @@ import (
 
 // Webhook is the main resource schema data
 type Webhook struct {
-	ID                    types.String            `tfsdk:"id"`
-	SpaceId               types.String            `tfsdk:"space_id"`
-	Version               types.Int64             `tfsdk:"version"`
-	Name                  types.String            `tfsdk:"name"`
-	URL                   types.String            `tfsdk:"url"`
-	HttpBasicAuthUsername types.String            `tfsdk:"http_basic_auth_username"`
-	HttpBasicAuthPassword types.String            `tfsdk:"http_basic_auth_password"`
-	Headers               map[string]types.String `tfsdk:"headers"`
-	Topics                []types.String          `tfsdk:"topics"`
-	Filters               types.String            `tfsdk:"filters"`
-	Active                types.Bool              `tfsdk:"active"`
+	ID                           types.String            `tfsdk:"id"`
+	SpaceId                      types.String            `tfsdk:"space_id"`
+	Version                      types.Int64             `tfsdk:"version"`
+	Name                         types.String            `tfsdk:"name"`
+	URL                          types.String            `tfsdk:"url"`
+	HttpBasicAuthUsername        types.String            `tfsdk:"http_basic_auth_username"`
+	HttpBasicAuthPassword        types.String            `tfsdk:"http_basic_auth_password"`
+	HttpBasicAuthPasswordVersion types.Int64             `tfsdk:"http_basic_auth_password_version"`
+	Headers                      map[string]types.String `tfsdk:"headers"`
+	SecretHeaders                map[string]types.String `tfsdk:"secret_headers"`
+	SecretHeadersVersion         types.Int64             `tfsdk:"secret_headers_version"`
+	Topics                       []types.String          `tfsdk:"topics"`
+	Filters                      types.String            `tfsdk:"filters"`
+	Filter                       []Filter                `tfsdk:"filter"`
+	Active                       types.Bool              `tfsdk:"active"`
+	Transformation               *Transformation         `tfsdk:"transformation"`
+	AdoptExisting                types.Bool              `tfsdk:"adopt_existing"`
+}
+
+// Filter is a condition on a property of the entity which triggered the
+// webhook. All filters of a webhook need to match.
+type Filter struct {
+	Doc    types.String   `tfsdk:"doc"`
+	Equals types.String   `tfsdk:"equals"`
+	In     []types.String `tfsdk:"in"`
+	Regexp types.String   `tfsdk:"regexp"`
+	Not    types.Bool     `tfsdk:"not"`
+}
+
+// Transformation changes the request which is sent by the webhook
+type Transformation struct {
+	Method               types.String         `tfsdk:"method"`
+	ContentType          types.String         `tfsdk:"content_type"`
+	IncludeContentLength types.Bool           `tfsdk:"include_content_length"`
+	Body                 jsontypes.Normalized `tfsdk:"body"`
 }
 
 // MapFromSDK populates the Webhook struct from an SDK webhook object
@@ -40,11 +67,15 @@ func (w *Webhook) MapFromSDK(webhook *sdk.Webhook) error {
 		w.HttpBasicAuthUsername = types.StringValue(*webhook.HttpBasicUsername)
 	}
 
-	// Convert headers
+	// Convert headers, the values of secret headers are never returned and
+	// are only known from the configuration
 	w.Headers = make(map[string]types.String)
 	if webhook.Headers != nil {
 		for _, header := range webhook.Headers {
-			w.Headers[header.Key] = types.StringValue(header.Value)
+			if header.Secret != nil && *header.Secret {
+				continue
+			}
+			w.Headers[header.Key] = types.StringPointerValue(header.Value)
 		}
 	}
 
@@ -66,9 +97,44 @@ func (w *Webhook) MapFromSDK(webhook *sdk.Webhook) error {
 
 	w.Filters = types.StringPointerValue(&filters)
 
+	// The filter blocks are only used when they are configured, otherwise the
+	// deprecated filters string is used
+	if len(w.Filter) > 0 {
+		w.Filter, _ = filtersFromSDK(webhook.Filters)
+	} else {
+		w.Filter = []Filter{}
+	}
+
+	w.Transformation = nil
+	if webhook.Transformation != nil {
+		transformation := &Transformation{
+			Method:               types.StringPointerValue(webhook.Transformation.Method),
+			ContentType:          types.StringPointerValue(webhook.Transformation.ContentType),
+			IncludeContentLength: types.BoolPointerValue(webhook.Transformation.IncludeContentLength),
+			Body:                 jsontypes.NewNormalizedNull(),
+		}
+
+		if webhook.Transformation.Body != nil {
+			body, err := json.Marshal(webhook.Transformation.Body)
+			if err != nil {
+				return errors.New("failed to marshal transformation body: " + err.Error())
+			}
+			transformation.Body = jsontypes.NewNormalizedValue(string(body))
+		}
+
+		w.Transformation = transformation
+	}
+
 	return nil
 }
 
+// CopyVersions copies the versions of the write-only credentials, which are
+// not returned by Contentful
+func (w *Webhook) CopyVersions(plan *Webhook) {
+	w.HttpBasicAuthPasswordVersion = plan.HttpBasicAuthPasswordVersion
+	w.SecretHeadersVersion = plan.SecretHeadersVersion
+}
+
 // DraftForCreate creates a WebhookCreate object for creating a new webhook
 func (w *Webhook) DraftForCreate() (sdk.WebhookCreate, error) {
 	filters, err := w.filtersToSdk()
@@ -76,6 +142,11 @@ func (w *Webhook) DraftForCreate() (sdk.WebhookCreate, error) {
 		return sdk.WebhookCreate{}, err
 	}
 
+	transformation, err := w.transformationToSDK()
+	if err != nil {
+		return sdk.WebhookCreate{}, err
+	}
+
 	return sdk.WebhookCreate{
 		Name:              w.Name.ValueString(),
 		Url:               w.URL.ValueString(),
@@ -85,6 +156,7 @@ func (w *Webhook) DraftForCreate() (sdk.WebhookCreate, error) {
 		HttpBasicPassword: utils.Pointer(w.HttpBasicAuthPassword.ValueString()),
 		Active:            w.Active.ValueBoolPointer(),
 		Filters:           filters,
+		Transformation:    transformation,
 	}, nil
 }
 
@@ -95,6 +167,11 @@ func (w *Webhook) DraftForUpdate() (sdk.WebhookUpdate, error) {
 		return sdk.WebhookUpdate{}, err
 	}
 
+	transformation, err := w.transformationToSDK()
+	if err != nil {
+		return sdk.WebhookUpdate{}, err
+	}
+
 	return sdk.WebhookUpdate{
 		Name:              w.Name.ValueString(),
 		Url:               w.URL.ValueString(),
@@ -104,6 +181,7 @@ func (w *Webhook) DraftForUpdate() (sdk.WebhookUpdate, error) {
 		HttpBasicPassword: utils.Pointer(w.HttpBasicAuthPassword.ValueString()),
 		Active:            w.Active.ValueBoolPointer(),
 		Filters:           filters,
+		Transformation:    transformation,
 	}, err
 }
 
@@ -118,6 +196,13 @@ func (w *Webhook) topicsToSDK() []string {
 func (w *Webhook) filtersToSdk() (*[]map[string]interface{}, error) {
 	var filterContent = make([]map[string]interface{}, 0)
 
+	if len(w.Filter) > 0 {
+		for _, filter := range w.Filter {
+			filterContent = append(filterContent, filter.toSDK())
+		}
+		return &filterContent, nil
+	}
+
 	filter := w.Filters.ValueString()
 	if filter == "" {
 		return &filterContent, nil
@@ -131,6 +216,161 @@ func (w *Webhook) filtersToSdk() (*[]map[string]interface{}, error) {
 	return &filterContent, nil
 }
 
+// toSDK converts the filter to the filter format of Contentful, e.g.
+// {"equals": [{"doc": "sys.id"}, "value"]}
+func (f Filter) toSDK() map[string]interface{} {
+	doc := map[string]interface{}{"doc": f.Doc.ValueString()}
+
+	var condition map[string]interface{}
+	switch {
+	case f.In != nil:
+		values := pie.Map(f.In, func(v types.String) interface{} {
+			return v.ValueString()
+		})
+		condition = map[string]interface{}{"in": []interface{}{doc, values}}
+	case !f.Regexp.IsNull():
+		condition = map[string]interface{}{"regexp": []interface{}{doc, map[string]interface{}{"pattern": f.Regexp.ValueString()}}}
+	default:
+		condition = map[string]interface{}{"equals": []interface{}{doc, f.Equals.ValueString()}}
+	}
+
+	if f.Not.ValueBool() {
+		return map[string]interface{}{"not": condition}
+	}
+	return condition
+}
+
+// isKnown returns whether all values of the filter are known
+func (f Filter) isKnown() bool {
+	if f.Doc.IsUnknown() || f.Equals.IsUnknown() || f.Regexp.IsUnknown() || f.Not.IsUnknown() {
+		return false
+	}
+	return !slices.ContainsFunc(f.In, func(v types.String) bool { return v.IsUnknown() })
+}
+
+// ImportFilters sets the filter blocks of an imported webhook. The deprecated
+// filters string is used when a filter cannot be represented as a filter
+// block, otherwise the filter would be removed by the next apply.
+func (w *Webhook) ImportFilters(webhook *sdk.Webhook) {
+	if filters, ok := filtersFromSDK(webhook.Filters); ok {
+		w.Filter = filters
+	}
+}
+
+// filtersFromSDK converts the filters of Contentful to filter blocks. Filters
+// which cannot be represented as a filter block are skipped, in which case
+// false is returned.
+func filtersFromSDK(filters *[]map[string]interface{}) ([]Filter, bool) {
+	result := []Filter{}
+	if filters == nil {
+		return result, true
+	}
+
+	complete := true
+	for _, item := range *filters {
+		filter, ok := filterFromSDK(item)
+		if !ok {
+			complete = false
+			continue
+		}
+		result = append(result, filter)
+	}
+	return result, complete
+}
+
+func filterFromSDK(item map[string]interface{}) (Filter, bool) {
+	filter := Filter{
+		Equals: types.StringNull(),
+		Regexp: types.StringNull(),
+		Not:    types.BoolValue(false),
+	}
+
+	if condition, ok := item["not"].(map[string]interface{}); ok && len(item) == 1 {
+		filter.Not = types.BoolValue(true)
+		item = condition
+	}
+
+	if len(item) != 1 {
+		return filter, false
+	}
+
+	for operator, value := range item {
+		operands, ok := value.([]interface{})
+		if !ok || len(operands) != 2 {
+			return filter, false
+		}
+
+		doc, ok := operands[0].(map[string]interface{})
+		if !ok {
+			return filter, false
+		}
+		path, ok := doc["doc"].(string)
+		if !ok {
+			return filter, false
+		}
+		filter.Doc = types.StringValue(path)
+
+		switch operator {
+		case "equals":
+			equals, ok := operands[1].(string)
+			if !ok {
+				return filter, false
+			}
+			filter.Equals = types.StringValue(equals)
+		case "in":
+			values, ok := operands[1].([]interface{})
+			if !ok {
+				return filter, false
+			}
+			filter.In = []types.String{}
+			for _, v := range values {
+				value, ok := v.(string)
+				if !ok {
+					return filter, false
+				}
+				filter.In = append(filter.In, types.StringValue(value))
+			}
+		case "regexp":
+			value, ok := operands[1].(map[string]interface{})
+			if !ok {
+				return filter, false
+			}
+			pattern, ok := value["pattern"].(string)
+			if !ok {
+				return filter, false
+			}
+			filter.Regexp = types.StringValue(pattern)
+		default:
+			return filter, false
+		}
+	}
+
+	return filter, true
+}
+
+// Convert the transformation from Terraform types to the SDK transformation
+func (w *Webhook) transformationToSDK() (*sdk.WebhookTransformation, error) {
+	if w.Transformation == nil {
+		return nil, nil
+	}
+
+	transformation := &sdk.WebhookTransformation{
+		Method:               w.Transformation.Method.ValueStringPointer(),
+		ContentType:          w.Transformation.ContentType.ValueStringPointer(),
+		IncludeContentLength: w.Transformation.IncludeContentLength.ValueBoolPointer(),
+	}
+
+	if !w.Transformation.Body.IsNull() && !w.Transformation.Body.IsUnknown() {
+		var body interface{}
+		if err := json.Unmarshal([]byte(w.Transformation.Body.ValueString()), &body); err != nil {
+			return nil, errors.New("failed to unmarshal transformation body: " + err.Error())
+		}
+		transformation.Body = &body
+	}
+
+	return transformation, nil
+}
+
 // Convert headers from Terraform map to SDK WebhookHeader slice
 func (w *Webhook) headersToSDK() *[]sdk.WebhookHeader {
 	var headers []sdk.WebhookHeader
@@ -138,7 +378,15 @@ func (w *Webhook) headersToSDK() *[]sdk.WebhookHeader {
 	for key, value := range w.Headers {
 		headers = append(headers, sdk.WebhookHeader{
 			Key:   key,
-			Value: value.ValueString(),
+			Value: utils.Pointer(value.ValueString()),
+		})
+	}
+
+	for key, value := range w.SecretHeaders {
+		headers = append(headers, sdk.WebhookHeader{
+			Key:    key,
+			Value:  utils.Pointer(value.ValueString()),
+			Secret: utils.Pointer(true),
 		})
 	}
 
@@ -148,3 +396,69 @@ func (w *Webhook) headersToSDK() *[]sdk.WebhookHeader {
 
 	return &headers
 }
+
+// WebhookHealthData is the schema data of the webhook health datasource
+type WebhookHealthData struct {
+	ID           types.String `tfsdk:"id"`
+	SpaceId      types.String `tfsdk:"space_id"`
+	WebhookId    types.String `tfsdk:"webhook_id"`
+	TotalCalls   types.Int64  `tfsdk:"total_calls"`
+	HealthyCalls types.Int64  `tfsdk:"healthy_calls"`
+}
+
+// Import populates the WebhookHealthData struct from an SDK webhook health object
+func (w *WebhookHealthData) Import(health *sdk.WebhookHealth) {
+	w.ID = w.WebhookId
+	w.TotalCalls = types.Int64Value(int64(health.Calls.Total))
+	w.HealthyCalls = types.Int64Value(int64(health.Calls.Healthy))
+}
+
+// WebhookCallsData is the schema data of the webhook calls datasource
+type WebhookCallsData struct {
+	ID        types.String  `tfsdk:"id"`
+	SpaceId   types.String  `tfsdk:"space_id"`
+	WebhookId types.String  `tfsdk:"webhook_id"`
+	Calls     []WebhookCall `tfsdk:"calls"`
+}
+
+// WebhookCall is an overview of a single call of a webhook
+type WebhookCall struct {
+	ID         types.String   `tfsdk:"id"`
+	URL        types.String   `tfsdk:"url"`
+	EventType  types.String   `tfsdk:"event_type"`
+	StatusCode types.Int64    `tfsdk:"status_code"`
+	Errors     []types.String `tfsdk:"errors"`
+	RequestAt  types.String   `tfsdk:"request_at"`
+	ResponseAt types.String   `tfsdk:"response_at"`
+}
+
+// Import populates the WebhookCallsData struct from an SDK webhook call collection
+func (w *WebhookCallsData) Import(calls *sdk.WebhookCallCollection) {
+	w.ID = w.WebhookId
+	w.Calls = pie.Map(calls.Items, func(call sdk.WebhookCallOverview) WebhookCall {
+		result := WebhookCall{
+			ID:         types.StringValue(call.Sys.Id),
+			URL:        types.StringValue(call.Url),
+			EventType:  types.StringPointerValue(call.EventType),
+			StatusCode: types.Int64Value(int64(call.StatusCode)),
+			Errors:     []types.String{},
+			RequestAt:  timeValue(call.RequestAt),
+			ResponseAt: timeValue(call.ResponseAt),
+		}
+
+		if call.Errors != nil {
+			result.Errors = pie.Map(*call.Errors, func(e string) types.String {
+				return types.StringValue(e)
+			})
+		}
+
+		return result
+	})
+}
+
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339Nano))
+}
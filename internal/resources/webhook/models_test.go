@@ -0,0 +1,252 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-contentful/internal/resources/webhook"
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const testWebhookData = `{
+	"name": "deploy",
+	"url": "https://example.com/deploy",
+	"topics": ["Entry.publish"],
+	"transformation": {
+		"method": "PUT",
+		"contentType": "application/json",
+		"includeContentLength": true,
+		"body": {"id": "{ /payload/sys/id }", "topic": "{ /topic }"}
+	},
+	"sys": {
+		"id": "deploy", "version": 2,
+		"space": {"sys": {"id": "space", "type": "Link", "linkType": "Space"}}
+	}
+}`
+
+func TestWebhookTransformationRoundTrip(t *testing.T) {
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+
+	state := &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+
+	assert.NotNil(t, state.Transformation)
+	assert.Equal(t, types.StringValue("PUT"), state.Transformation.Method)
+	assert.Equal(t, types.StringValue("application/json"), state.Transformation.ContentType)
+	assert.Equal(t, types.BoolValue(true), state.Transformation.IncludeContentLength)
+
+	equal, diags := state.Transformation.Body.StringSemanticEquals(context.Background(), jsontypes.NewNormalizedValue(`{"topic": "{ /topic }", "id": "{ /payload/sys/id }"}`))
+	assert.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	draft, err := state.DraftForCreate()
+	assert.NoError(t, err)
+
+	transformation, err := json.Marshal(draft.Transformation)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"method": "PUT",
+		"contentType": "application/json",
+		"includeContentLength": true,
+		"body": {"id": "{ /payload/sys/id }", "topic": "{ /topic }"}
+	}`, string(transformation))
+}
+
+func TestWebhookWithoutTransformation(t *testing.T) {
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+	data.Transformation = nil
+
+	state := &webhook.Webhook{Transformation: &webhook.Transformation{}}
+	assert.NoError(t, state.MapFromSDK(data))
+	assert.Nil(t, state.Transformation)
+
+	draft, err := state.DraftForUpdate()
+	assert.NoError(t, err)
+	assert.Nil(t, draft.Transformation)
+}
+
+func TestWebhookFilterRoundTrip(t *testing.T) {
+	plan := &webhook.Webhook{
+		Filter: []webhook.Filter{
+			{
+				Doc:    types.StringValue("sys.environment.sys.id"),
+				In:     []types.String{types.StringValue("testing"), types.StringValue("staging")},
+				Equals: types.StringNull(),
+				Regexp: types.StringNull(),
+				Not:    types.BoolValue(false),
+			},
+			{
+				Doc:    types.StringValue("sys.environment.sys.id"),
+				Equals: types.StringValue("master"),
+				Regexp: types.StringNull(),
+				Not:    types.BoolValue(true),
+			},
+			{
+				Doc:    types.StringValue("sys.id"),
+				Equals: types.StringNull(),
+				Regexp: types.StringValue("^blog-"),
+				Not:    types.BoolValue(false),
+			},
+		},
+	}
+
+	draft, err := plan.DraftForCreate()
+	assert.NoError(t, err)
+
+	filters, err := json.Marshal(draft.Filters)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"in": [{"doc": "sys.environment.sys.id"}, ["testing", "staging"]]},
+		{"not": {"equals": [{"doc": "sys.environment.sys.id"}, "master"]}},
+		{"regexp": [{"doc": "sys.id"}, {"pattern": "^blog-"}]}
+	]`, string(filters))
+
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+	data.Filters = draft.Filters
+
+	state := &webhook.Webhook{Filter: plan.Filter}
+	assert.NoError(t, state.MapFromSDK(data))
+	assert.Equal(t, plan.Filter, state.Filter)
+	assert.Equal(t, types.StringValue(string(filters)), state.Filters)
+}
+
+func TestWebhookFiltersString(t *testing.T) {
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+	data.Filters = &[]map[string]interface{}{
+		{"equals": []interface{}{map[string]interface{}{"doc": "sys.id"}, "home"}},
+	}
+
+	// Without filter blocks only the deprecated filters string is set
+	state := &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+	assert.Equal(t, types.StringValue(`[{"equals":[{"doc":"sys.id"},"home"]}]`), state.Filters)
+	assert.Empty(t, state.Filter)
+	assert.NotNil(t, state.Filter)
+
+	draft, err := state.DraftForUpdate()
+	assert.NoError(t, err)
+	assert.Equal(t, data.Filters, draft.Filters)
+}
+
+func TestWebhookImportFilters(t *testing.T) {
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+	data.Filters = &[]map[string]interface{}{
+		{"equals": []interface{}{map[string]interface{}{"doc": "sys.id"}, "home"}},
+	}
+
+	state := &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+	state.ImportFilters(data)
+	assert.Len(t, state.Filter, 1)
+	assert.Equal(t, types.StringValue("home"), state.Filter[0].Equals)
+
+	// A filter which cannot be represented as a block keeps the filters string
+	data.Filters = &[]map[string]interface{}{
+		{"equals": []interface{}{map[string]interface{}{"doc": "sys.id"}, "home"}},
+		{"and": []interface{}{
+			map[string]interface{}{"equals": []interface{}{map[string]interface{}{"doc": "sys.id"}, "blog"}},
+		}},
+	}
+
+	state = &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+	state.ImportFilters(data)
+	assert.Empty(t, state.Filter)
+
+	draft, err := state.DraftForUpdate()
+	assert.NoError(t, err)
+	assert.Equal(t, data.Filters, draft.Filters)
+
+	// A regexp filter without a pattern object is kept as filters string
+	data.Filters = &[]map[string]interface{}{
+		{"regexp": []interface{}{map[string]interface{}{"doc": "sys.id"}, "^blog-"}},
+	}
+
+	state = &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+	state.ImportFilters(data)
+	assert.Empty(t, state.Filter)
+	assert.Equal(t, types.StringValue(`[{"regexp":[{"doc":"sys.id"},"^blog-"]}]`), state.Filters)
+}
+
+func TestWebhookSecretHeaders(t *testing.T) {
+	plan := &webhook.Webhook{
+		Headers:       map[string]types.String{"X-Source": types.StringValue("contentful")},
+		SecretHeaders: map[string]types.String{"Authorization": types.StringValue("Bearer secret")},
+	}
+
+	draft, err := plan.DraftForCreate()
+	assert.NoError(t, err)
+
+	headers, err := json.Marshal(draft.Headers)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"key": "X-Source", "value": "contentful"},
+		{"key": "Authorization", "value": "Bearer secret", "secret": true}
+	]`, string(headers))
+
+	// The values of secret headers are never returned
+	data := &sdk.Webhook{}
+	assert.NoError(t, json.Unmarshal([]byte(testWebhookData), data))
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"key": "X-Source", "value": "contentful"},
+		{"key": "Authorization", "secret": true}
+	]`), &data.Headers))
+
+	state := &webhook.Webhook{}
+	assert.NoError(t, state.MapFromSDK(data))
+	assert.Equal(t, plan.Headers, state.Headers)
+	assert.Nil(t, state.SecretHeaders)
+}
+
+func TestWebhookCallsDataImport(t *testing.T) {
+	calls := &sdk.WebhookCallCollection{}
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"items": [
+			{
+				"sys": {"id": "call-1"}, "url": "https://example.com/deploy", "eventType": "publish", "statusCode": 200,
+				"errors": [], "requestAt": "2026-10-18T10:00:00.123Z", "responseAt": "2026-10-18T10:00:01Z"
+			},
+			{
+				"sys": {"id": "call-2"}, "url": "https://example.com/deploy", "eventType": "unpublish", "statusCode": 0,
+				"errors": ["TimeoutError"], "requestAt": "2026-10-18T11:00:00Z"
+			}
+		],
+		"total": 2
+	}`), calls))
+
+	data := &webhook.WebhookCallsData{WebhookId: types.StringValue("deploy")}
+	data.Import(calls)
+
+	assert.Equal(t, types.StringValue("deploy"), data.ID)
+	assert.Equal(t, []webhook.WebhookCall{
+		{
+			ID:         types.StringValue("call-1"),
+			URL:        types.StringValue("https://example.com/deploy"),
+			EventType:  types.StringValue("publish"),
+			StatusCode: types.Int64Value(200),
+			Errors:     []types.String{},
+			RequestAt:  types.StringValue("2026-10-18T10:00:00.123Z"),
+			ResponseAt: types.StringValue("2026-10-18T10:00:01Z"),
+		},
+		{
+			ID:         types.StringValue("call-2"),
+			URL:        types.StringValue("https://example.com/deploy"),
+			EventType:  types.StringValue("unpublish"),
+			StatusCode: types.Int64Value(0),
+			Errors:     []types.String{types.StringValue("TimeoutError")},
+			RequestAt:  types.StringValue("2026-10-18T11:00:00Z"),
+			ResponseAt: types.StringNull(),
+		},
+	}, data.Calls)
+}
@@ -2,27 +2,38 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/labd/terraform-provider-contentful/internal/customvalidator"
 	"github.com/labd/terraform-provider-contentful/internal/sdk"
 	"github.com/labd/terraform-provider-contentful/internal/utils"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &webhookResource{}
-	_ resource.ResourceWithConfigure   = &webhookResource{}
-	_ resource.ResourceWithImportState = &webhookResource{}
+	_ resource.Resource                   = &webhookResource{}
+	_ resource.ResourceWithConfigure      = &webhookResource{}
+	_ resource.ResourceWithImportState    = &webhookResource{}
+	_ resource.ResourceWithValidateConfig = &webhookResource{}
+	_ resource.ResourceWithModifyPlan     = &webhookResource{}
 )
 
 func NewWebhookResource() resource.Resource {
@@ -31,7 +42,8 @@ func NewWebhookResource() resource.Resource {
 
 // webhookResource is the resource implementation.
 type webhookResource struct {
-	client *sdk.ClientWithResponses
+	client        *sdk.ClientWithResponses
+	adoptExisting bool
 }
 
 func (e *webhookResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -73,19 +85,42 @@ func (e *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "HTTP basic auth username",
 			},
 			"http_basic_auth_password": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Description: "HTTP basic auth password. The password is write-only and not stored in the state, change " +
+					"`http_basic_auth_password_version` to update it.",
+			},
+			"http_basic_auth_password_version": schema.Int64Attribute{
 				Optional:    true,
-				Description: "HTTP basic auth password",
-				Sensitive:   true,
+				Description: "Version of the HTTP basic auth password, change it to update the password",
 			},
 			"headers": schema.MapAttribute{
 				Optional:    true,
 				Description: "HTTP headers to send with the webhook request",
 				ElementType: types.StringType,
 			},
+			"secret_headers": schema.MapAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "Secret HTTP headers to send with the webhook request. The values of secret headers are " +
+					"never returned by Contentful and are not stored in the state, change `secret_headers_version` to " +
+					"update them.",
+			},
+			"secret_headers_version": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Version of the secret headers, change it to update the secret headers",
+			},
 			"topics": schema.ListAttribute{
-				Required:    true,
-				Description: "List of topics this webhook should be triggered for",
+				Required: true,
+				Description: "List of topics this webhook should be triggered for, in the format `<Entity>.<action>`, " +
+					"e.g. `Entry.publish`. Both the entity and the action can be a wildcard, e.g. `Asset.*` or `*.publish`",
 				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(customvalidator.WebhookTopicValidator()),
+				},
 			},
 			"active": schema.BoolAttribute{
 				Computed:    true,
@@ -98,12 +133,172 @@ func (e *webhookResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional: true,
 				Description: "List of filters this webhook should match for before triggering. The filters should be " +
 					"provided as a JSON string. For example: {\"sys\":{\"type\":\"Entry\"}}",
-				Default: stringdefault.StaticString("[]"),
+				DeprecationMessage: "Use the `filter` block instead, the filters string will be removed in a future version.",
+			},
+			"adopt_existing": utils.AdoptExistingAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"filter": schema.ListNestedBlock{
+				Description: "Filter the entities this webhook is triggered for, all filters need to match. Each filter " +
+					"compares the `doc` property with exactly one of `equals`, `in` or `regexp`.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"doc": schema.StringAttribute{
+							Required:    true,
+							Description: "The property of the entity to filter on",
+							Validators: []validator.String{
+								stringvalidator.OneOf("sys.environment.sys.id", "sys.contentType.sys.id", "sys.id"),
+							},
+						},
+						"equals": schema.StringAttribute{
+							Optional:    true,
+							Description: "The property needs to be equal to this value",
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("in"),
+									path.MatchRelative().AtParent().AtName("regexp"),
+								),
+							},
+						},
+						"in": schema.ListAttribute{
+							Optional:    true,
+							Description: "The property needs to be equal to one of these values",
+							ElementType: types.StringType,
+						},
+						"regexp": schema.StringAttribute{
+							Optional:    true,
+							Description: "The property needs to match this regular expression",
+						},
+						"not": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether the filter is negated",
+							Default:     booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"transformation": schema.SingleNestedBlock{
+				Description: "Changes the request which is sent by the webhook",
+				Attributes: map[string]schema.Attribute{
+					"method": schema.StringAttribute{
+						Optional:    true,
+						Description: "HTTP method of the request, defaults to POST",
+						Validators: []validator.String{
+							stringvalidator.OneOf("POST", "GET", "PUT", "PATCH", "DELETE"),
+						},
+					},
+					"content_type": schema.StringAttribute{
+						Optional:    true,
+						Description: "Content type of the request",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"application/vnd.contentful.management.v1+json",
+								"application/vnd.contentful.management.v1+json; charset=utf-8",
+								"application/json",
+								"application/json; charset=utf-8",
+								"application/x-www-form-urlencoded",
+								"application/x-www-form-urlencoded; charset=utf-8",
+							),
+						},
+					},
+					"include_content_length": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether the Content-Length header is included in the request",
+					},
+					"body": schema.StringAttribute{
+						Optional:   true,
+						CustomType: jsontypes.NormalizedType{},
+						Description: "Custom body of the request as a JSON string. String values can contain JSON pointers " +
+							"into the webhook data, e.g. `{ /payload/sys/id }` or `{ /topic }`",
+						Validators: []validator.String{
+							customvalidator.WebhookBodyTemplateValidator(),
+						},
+					},
+				},
 			},
 		},
 	}
 }
 
+func (e *webhookResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var headers, secretHeaders types.Map
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("headers"), &headers)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("secret_headers"), &secretHeaders)...)
+
+	if !response.Diagnostics.HasError() && !headers.IsUnknown() {
+		for key := range secretHeaders.Elements() {
+			if _, ok := headers.Elements()[key]; ok {
+				response.Diagnostics.AddAttributeError(
+					path.Root("secret_headers").AtMapKey(key),
+					"Invalid webhook headers",
+					fmt.Sprintf("The header %q is defined in both headers and secret_headers.", key),
+				)
+			}
+		}
+	}
+
+	var filters types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("filters"), &filters)...)
+
+	var filter types.List
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("filter"), &filter)...)
+
+	if response.Diagnostics.HasError() || filters.IsNull() || filter.IsNull() {
+		return
+	}
+
+	if filter.IsUnknown() || len(filter.Elements()) > 0 {
+		response.Diagnostics.AddAttributeError(
+			path.Root("filters"),
+			"Invalid webhook filters",
+			"The filters string cannot be combined with filter blocks, use only filter blocks.",
+		)
+	}
+}
+
+// ModifyPlan computes the filters string from the filter blocks when the
+// deprecated filters string is not configured
+func (e *webhookResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var filters types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("filters"), &filters)...)
+
+	var filter types.List
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("filter"), &filter)...)
+
+	if response.Diagnostics.HasError() || !filters.IsNull() {
+		return
+	}
+
+	value := types.StringUnknown()
+	if !filter.IsUnknown() {
+		plan := Webhook{}
+		response.Diagnostics.Append(filter.ElementsAs(ctx, &plan.Filter, false)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !slices.ContainsFunc(plan.Filter, func(f Filter) bool { return !f.isKnown() }) {
+			content, err := plan.filtersToSdk()
+			if err == nil {
+				var data []byte
+				data, err = json.Marshal(content)
+				value = types.StringValue(string(data))
+			}
+			if err != nil {
+				response.Diagnostics.AddError("Error planning webhook", "Could not convert filters: "+err.Error())
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("filters"), value)...)
+}
+
 func (e *webhookResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
 	if request.ProviderData == nil {
 		return
@@ -111,16 +306,72 @@ func (e *webhookResource) Configure(_ context.Context, request resource.Configur
 
 	data := request.ProviderData.(utils.ProviderData)
 	e.client = data.Client
+	e.adoptExisting = data.AdoptExisting
 }
 
 func (e *webhookResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
 	// Get plan values
 	var plan Webhook
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(getWriteOnlyValues(ctx, request.Config, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	// Webhooks are identified by their name, adopt the webhook with the same
+	// name when exactly one such webhook exists
+	if utils.ShouldAdopt(e.adoptExisting, plan.AdoptExisting) {
+		existing, err := e.findWebhookByName(ctx, plan.SpaceId.ValueString(), plan.Name.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(
+				"Error creating webhook",
+				"Could not adopt webhook: "+err.Error(),
+			)
+			return
+		}
+
+		if existing != nil {
+			draft, err := plan.DraftForUpdate()
+			if err != nil {
+				response.Diagnostics.AddError(
+					"Error creating webhook",
+					"Could not adopt webhook: "+err.Error(),
+				)
+				return
+			}
+
+			resp, err := e.client.UpdateWebhookWithResponse(
+				ctx,
+				plan.SpaceId.ValueString(),
+				*existing.Sys.Id,
+				&sdk.UpdateWebhookParams{XContentfulVersion: *existing.Sys.Version},
+				draft,
+			)
+			if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+				response.Diagnostics.AddError(
+					"Error creating webhook",
+					"Could not adopt webhook: "+err.Error(),
+				)
+				return
+			}
+
+			utils.AddAdoptedWarning(&response.Diagnostics, "webhook", plan.Name.ValueString())
+
+			state := &Webhook{AdoptExisting: plan.AdoptExisting, Filter: plan.Filter}
+			if err := state.MapFromSDK(resp.JSON200); err != nil {
+				response.Diagnostics.AddError(
+					"Error mapping webhook",
+					"Could not import webhook: "+err.Error(),
+				)
+				return
+			}
+			state.CopyVersions(&plan)
+
+			response.Diagnostics.Append(response.State.Set(ctx, state)...)
+			return
+		}
+	}
+
 	// Create the webhook
 	draft, err := plan.DraftForCreate()
 	if err != nil {
@@ -141,7 +392,7 @@ func (e *webhookResource) Create(ctx context.Context, request resource.CreateReq
 	}
 
 	// Map response to state
-	state := &Webhook{}
+	state := &Webhook{AdoptExisting: plan.AdoptExisting, Filter: plan.Filter}
 	err = state.MapFromSDK(resp.JSON201)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -151,7 +402,7 @@ func (e *webhookResource) Create(ctx context.Context, request resource.CreateReq
 		return
 	}
 
-	state.HttpBasicAuthPassword = plan.HttpBasicAuthPassword
+	state.CopyVersions(&plan)
 
 	// Set state
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
@@ -191,6 +442,7 @@ func (e *webhookResource) Update(ctx context.Context, request resource.UpdateReq
 	// Get plan values
 	var plan Webhook
 	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(getWriteOnlyValues(ctx, request.Config, &plan)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
@@ -232,6 +484,7 @@ func (e *webhookResource) Update(ctx context.Context, request resource.UpdateReq
 		return
 	}
 
+	state.Filter = plan.Filter
 	err = state.MapFromSDK(resp.JSON200)
 	if err != nil {
 		response.Diagnostics.AddError(
@@ -240,7 +493,8 @@ func (e *webhookResource) Update(ctx context.Context, request resource.UpdateReq
 		)
 		return
 	}
-	state.HttpBasicAuthPassword = plan.HttpBasicAuthPassword
+	state.AdoptExisting = plan.AdoptExisting
+	state.CopyVersions(&plan)
 
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
@@ -305,5 +559,60 @@ func (e *webhookResource) ImportState(ctx context.Context, request resource.Impo
 		)
 		return
 	}
+
+	// Imported webhooks use the filter blocks instead of the deprecated
+	// filters string when possible
+	state.ImportFilters(resp.JSON200)
+
 	response.Diagnostics.Append(response.State.Set(ctx, state)...)
 }
+
+// getWriteOnlyValues reads the write-only credentials from the configuration,
+// they are always null in the plan
+func getWriteOnlyValues(ctx context.Context, config tfsdk.Config, plan *Webhook) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(config.GetAttribute(ctx, path.Root("http_basic_auth_password"), &plan.HttpBasicAuthPassword)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_headers"), &plan.SecretHeaders)...)
+	return diags
+}
+
+// findWebhookByName returns the webhook with the given name, or nil when the
+// space has no such webhook. Names are not unique, an error is returned when
+// more than one webhook has the given name.
+func (e *webhookResource) findWebhookByName(ctx context.Context, spaceId string, name string) (*sdk.Webhook, error) {
+	var matches []sdk.Webhook
+
+	limit := 100
+	for skip := 0; ; skip += limit {
+		resp, err := e.client.GetAllWebhooksWithResponse(ctx, spaceId, &sdk.GetAllWebhooksParams{
+			Limit: &limit,
+			Skip:  utils.Pointer(skip),
+		})
+		if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			return nil, err
+		}
+
+		if resp.JSON200.Items == nil {
+			break
+		}
+
+		for _, webhook := range *resp.JSON200.Items {
+			if webhook.Name == name {
+				matches = append(matches, webhook)
+			}
+		}
+
+		if len(*resp.JSON200.Items) < limit {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d webhooks are named %q, import the webhook to adopt it by id", len(matches), name)
+	}
+}
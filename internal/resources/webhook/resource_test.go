@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -61,6 +62,9 @@ func TestWebhookResource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "http_basic_auth_username", "username-updated"),
 					resource.TestCheckResourceAttr(resourceName, "topics.#", "3"),
 					resource.TestCheckResourceAttr(resourceName, "headers.header1", "header1-value-updated"),
+					resource.TestCheckResourceAttr(resourceName, "headers.%", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_headers.%"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_basic_auth_password"),
 					testAccCheckContentfulWebhookExists(t, resourceName, func(t *testing.T, webhook *sdk.Webhook) {
 						assert.EqualValues(t, fmt.Sprintf("%s-updated", name), webhook.Name)
 						assert.EqualValues(t, fmt.Sprintf("%s-updated", url), webhook.Url)
@@ -69,9 +73,14 @@ func TestWebhookResource_Basic(t *testing.T) {
 						assert.Contains(t, webhook.Topics, "Entry.create")
 						assert.Contains(t, webhook.Topics, "ContentType.create")
 						assert.Contains(t, webhook.Topics, "Asset.*")
-						assert.Len(t, webhook.Headers, 2)
+						assert.Len(t, webhook.Headers, 3)
+						assert.EqualValues(t, "PUT", *webhook.Transformation.Method)
 					}),
 					resource.TestCheckResourceAttr(resourceName, "active", "false"),
+					resource.TestCheckResourceAttr(resourceName, "transformation.method", "PUT"),
+					resource.TestCheckResourceAttr(resourceName, "transformation.content_type", "application/json"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "filter.1.not", "true"),
 					resource.TestCheckResourceAttr(resourceName, "filters", "[{\"in\":[{\"doc\":\"sys.environment.sys.id\"},[\"testing\",\"staging\"]]},{\"not\":{\"equals\":[{\"doc\":\"sys.environment.sys.id\"},\"master\"]}}]"),
 				),
 			},
@@ -79,7 +88,7 @@ func TestWebhookResource_Basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"http_basic_auth_password"},
+				ImportStateVerifyIgnore: []string{"http_basic_auth_password_version", "secret_headers_version"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					rs, ok := s.RootModule().Resources[resourceName]
 					if !ok {
@@ -92,6 +101,91 @@ func TestWebhookResource_Basic(t *testing.T) {
 	})
 }
 
+func TestWebhookResource_AdoptExisting(t *testing.T) {
+	name := fmt.Sprintf("webhook-name-%s", hashicoracctest.RandString(3))
+	url := "https://www.example.com/test"
+	resourceName := "contentful_webhook.mywebhook"
+	var existingId string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulWebhookDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					resp, err := client.CreateWebhookWithResponse(context.Background(), os.Getenv("CONTENTFUL_SPACE_ID"), sdk.WebhookCreate{
+						Name:   name,
+						Url:    "https://www.example.com/existing",
+						Topics: []string{"Entry.create"},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					if resp.StatusCode() != 201 {
+						t.Fatalf("unexpected status code creating webhook: %d", resp.StatusCode())
+					}
+					existingId = *resp.JSON201.Sys.Id
+				},
+				Config: testWebhookAdoptExisting(os.Getenv("CONTENTFUL_SPACE_ID"), name, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "url", url),
+					resource.TestCheckResourceAttr(resourceName, "adopt_existing", "true"),
+					testAccCheckContentfulWebhookExists(t, resourceName, func(t *testing.T, webhook *sdk.Webhook) {
+						assert.Equal(t, existingId, *webhook.Sys.Id)
+						assert.EqualValues(t, url, webhook.Url)
+						assert.Len(t, webhook.Topics, 2)
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestWebhookResource_AdoptExistingDuplicateName(t *testing.T) {
+	name := fmt.Sprintf("webhook-name-%s", hashicoracctest.RandString(3))
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulWebhookDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := acctest.GetClient()
+					for i := 0; i < 2; i++ {
+						resp, err := client.CreateWebhookWithResponse(context.Background(), spaceID, sdk.WebhookCreate{
+							Name:   name,
+							Url:    fmt.Sprintf("https://www.example.com/existing-%d", i),
+							Topics: []string{"Entry.create"},
+						})
+						if err != nil {
+							t.Fatal(err)
+						}
+						if resp.StatusCode() != 201 {
+							t.Fatalf("unexpected status code creating webhook: %d", resp.StatusCode())
+						}
+
+						id, version := *resp.JSON201.Sys.Id, *resp.JSON201.Sys.Version
+						t.Cleanup(func() {
+							_, _ = client.DeleteWebhookWithResponse(context.Background(), spaceID, id, &sdk.DeleteWebhookParams{XContentfulVersion: version})
+						})
+					}
+				},
+				Config:      testWebhookAdoptExisting(spaceID, name, "https://www.example.com/test"),
+				ExpectError: regexp.MustCompile(`2 webhooks are named`),
+			},
+		},
+	})
+}
+
 func testAccCheckContentfulWebhookExists(t *testing.T, resourceName string, assertFunc assertFunc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		webhook, err := getWebhookFromState(s, resourceName)
@@ -180,8 +274,9 @@ resource "contentful_webhook" "mywebhook" {
     header1 = "header1-value"
     header2 = "header2-value"
   }
-  http_basic_auth_username = "username"
-  http_basic_auth_password = "password"
+  http_basic_auth_username         = "username"
+  http_basic_auth_password         = "password"
+  http_basic_auth_password_version = 1
 }
 `, spaceId, name, url)
 }
@@ -202,12 +297,42 @@ resource "contentful_webhook" "mywebhook" {
     header1 = "header1-value-updated"
     header2 = "header2-value-updated"
   }
-  http_basic_auth_username = "username-updated"
-  http_basic_auth_password = "password-updated"
-  filters = jsonencode([
-    {in: [{ "doc" : "sys.environment.sys.id" }, ["testing", "staging" ]]},
-    { not : {equals: [{ "doc" : "sys.environment.sys.id" }, "master"]} },
-  ])	
+  secret_headers = {
+    Authorization = "Bearer secret"
+  }
+  secret_headers_version           = 1
+  http_basic_auth_username         = "username-updated"
+  http_basic_auth_password         = "password-updated"
+  http_basic_auth_password_version = 2
+  filter {
+    doc = "sys.environment.sys.id"
+    in  = ["testing", "staging"]
+  }
+  filter {
+    doc    = "sys.environment.sys.id"
+    equals = "master"
+    not    = true
+  }
+  transformation {
+    method       = "PUT"
+    content_type = "application/json"
+    body         = jsonencode({ id = "{ /payload/sys/id }", topic = "{ /topic }" })
+  }
+}
+`, spaceId, name, url)
+}
+
+func testWebhookAdoptExisting(spaceId string, name string, url string) string {
+	return fmt.Sprintf(`
+resource "contentful_webhook" "mywebhook" {
+  space_id       = "%s"
+  name           = "%s"
+  url            = "%s"
+  adopt_existing = true
+  topics = [
+    "Entry.create",
+    "ContentType.create"
+  ]
 }
 `, spaceId, name, url)
 }
@@ -0,0 +1,68 @@
+package webhook_signing_secret
+
+import (
+	"crypto/rand"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+const (
+	secretLength     = 64
+	secretCharacters = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// WebhookSigningSecret is the main resource schema data
+type WebhookSigningSecret struct {
+	ID              types.String `tfsdk:"id"`
+	SpaceId         types.String `tfsdk:"space_id"`
+	Value           types.String `tfsdk:"value"`
+	RotationTrigger types.String `tfsdk:"rotation_trigger"`
+	RedactedValue   types.String `tfsdk:"redacted_value"`
+}
+
+// Import populates the state from the redacted signing secret, the value of
+// the secret is never returned
+func (w *WebhookSigningSecret) Import(n *sdk.WebhookSigningSecret) {
+	w.ID = w.SpaceId
+	w.RedactedValue = types.StringValue(n.RedactedValue)
+}
+
+// Draft returns the signing secret to set, a secret is generated when no value
+// is configured
+func (w *WebhookSigningSecret) Draft() (sdk.WebhookSigningSecretUpdate, error) {
+	if !w.Value.IsNull() {
+		return sdk.WebhookSigningSecretUpdate{Value: w.Value.ValueString()}, nil
+	}
+
+	value, err := generateSecret()
+	if err != nil {
+		return sdk.WebhookSigningSecretUpdate{}, err
+	}
+
+	return sdk.WebhookSigningSecretUpdate{Value: value}, nil
+}
+
+// generateSecret returns a random secret of 64 alphanumeric characters
+func generateSecret() (string, error) {
+	// Bytes above the largest multiple of the number of characters are
+	// skipped, so every character is equally likely
+	limit := byte(256 - 256%len(secretCharacters))
+
+	result := make([]byte, 0, secretLength)
+	buffer := make([]byte, secretLength)
+	for len(result) < secretLength {
+		if _, err := rand.Read(buffer); err != nil {
+			return "", err
+		}
+
+		for _, b := range buffer {
+			if b < limit && len(result) < secretLength {
+				result = append(result, secretCharacters[int(b)%len(secretCharacters)])
+			}
+		}
+	}
+
+	return string(result), nil
+}
@@ -0,0 +1,32 @@
+package webhook_signing_secret
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDraftUsesConfiguredValue(t *testing.T) {
+	value := strings.Repeat("a1B2", 16)
+	secret := &WebhookSigningSecret{Value: types.StringValue(value)}
+
+	draft, err := secret.Draft()
+
+	assert.NoError(t, err)
+	assert.Equal(t, value, draft.Value)
+}
+
+func TestDraftGeneratesValue(t *testing.T) {
+	secret := &WebhookSigningSecret{Value: types.StringNull()}
+
+	first, err := secret.Draft()
+	assert.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^[0-9a-zA-Z]{64}$`), first.Value)
+
+	second, err := secret.Draft()
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.Value, second.Value)
+}
@@ -0,0 +1,216 @@
+package webhook_signing_secret
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+	"github.com/labd/terraform-provider-contentful/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &webhookSigningSecretResource{}
+	_ resource.ResourceWithConfigure   = &webhookSigningSecretResource{}
+	_ resource.ResourceWithImportState = &webhookSigningSecretResource{}
+)
+
+func NewWebhookSigningSecretResource() resource.Resource {
+	return &webhookSigningSecretResource{}
+}
+
+// webhookSigningSecretResource is the resource implementation.
+type webhookSigningSecretResource struct {
+	client *sdk.ClientWithResponses
+}
+
+func (e *webhookSigningSecretResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_webhook_signing_secret"
+}
+
+func (e *webhookSigningSecretResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "The signing secret of a space is used to sign the requests of all webhooks in the space, so " +
+			"receivers can verify that a request was sent by Contentful. Only the redacted secret is stored in the state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the space",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Required:    true,
+				Description: "Space ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Optional:  true,
+				WriteOnly: true,
+				Sensitive: true,
+				Description: "The signing secret of 64 alphanumeric characters. A random secret is generated when no " +
+					"value is set, which cannot be retrieved afterwards. The value is write-only and not stored in the " +
+					"state, change `rotation_trigger` to update it.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[0-9a-zA-Z]{64}$`),
+						"must consist of exactly 64 alphanumeric characters",
+					),
+				},
+			},
+			"rotation_trigger": schema.StringAttribute{
+				Optional:    true,
+				Description: "Arbitrary value, the secret is rotated when it changes",
+			},
+			"redacted_value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The last characters of the signing secret",
+			},
+		},
+	}
+}
+
+func (e *webhookSigningSecretResource) Configure(_ context.Context, request resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	data := request.ProviderData.(utils.ProviderData)
+	e.client = data.Client
+}
+
+func (e *webhookSigningSecretResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan WebhookSigningSecret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &plan.Value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := e.setSecret(ctx, &plan); err != nil {
+		response.Diagnostics.AddError(
+			"Error creating webhook signing secret",
+			"Could not create webhook signing secret: "+err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *webhookSigningSecretResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state WebhookSigningSecret
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.GetWebhookSigningSecretWithResponse(ctx, state.SpaceId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			response.State.RemoveResource(ctx)
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error reading webhook signing secret",
+			"Could not read webhook signing secret: "+err.Error(),
+		)
+		return
+	}
+
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+func (e *webhookSigningSecretResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan WebhookSigningSecret
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("value"), &plan.Value)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The only attribute which can be updated in place is the rotation
+	// trigger, so every update rotates the secret
+	if err := e.setSecret(ctx, &plan); err != nil {
+		response.Diagnostics.AddError(
+			"Error updating webhook signing secret",
+			"Could not rotate webhook signing secret: "+err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, plan)...)
+}
+
+func (e *webhookSigningSecretResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state WebhookSigningSecret
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resp, err := e.client.DeleteWebhookSigningSecretWithResponse(ctx, state.SpaceId.ValueString())
+	if err := utils.CheckClientResponse(resp, err, http.StatusNoContent); err != nil {
+		if resp != nil && resp.StatusCode() == http.StatusNotFound {
+			return
+		}
+
+		response.Diagnostics.AddError(
+			"Error deleting webhook signing secret",
+			"Could not delete webhook signing secret: "+err.Error(),
+		)
+	}
+}
+
+func (e *webhookSigningSecretResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resp, err := e.client.GetWebhookSigningSecretWithResponse(ctx, request.ID)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		response.Diagnostics.AddError(
+			"Error importing webhook signing secret",
+			"Could not read webhook signing secret: "+err.Error(),
+		)
+		return
+	}
+
+	state := &WebhookSigningSecret{
+		SpaceId:         types.StringValue(request.ID),
+		RotationTrigger: types.StringNull(),
+	}
+	state.Import(resp.JSON200)
+
+	response.Diagnostics.Append(response.State.Set(ctx, state)...)
+}
+
+// setSecret sets the configured or a generated signing secret and updates the
+// model with the redacted secret
+func (e *webhookSigningSecretResource) setSecret(ctx context.Context, plan *WebhookSigningSecret) error {
+	draft, err := plan.Draft()
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.client.UpdateWebhookSigningSecretWithResponse(ctx, plan.SpaceId.ValueString(), draft)
+	if err := utils.CheckClientResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+
+	plan.Import(resp.JSON200)
+	plan.Value = types.StringNull()
+	return nil
+}
@@ -0,0 +1,107 @@
+package webhook_signing_secret_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/labd/terraform-provider-contentful/internal/acctest"
+	"github.com/labd/terraform-provider-contentful/internal/provider"
+)
+
+func TestWebhookSigningSecretResource_Basic(t *testing.T) {
+	spaceID := os.Getenv("CONTENTFUL_SPACE_ID")
+	resourceName := "contentful_webhook_signing_secret.signing"
+	value := strings.Repeat("tfTest42", 8)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckContentfulWebhookSigningSecretDestroy,
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"contentful": providerserver.NewProtocol6WithError(provider.New("test", true)()),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testWebhookSigningSecretConfig(spaceID, value, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", spaceID),
+					resource.TestCheckNoResourceAttr(resourceName, "value"),
+					resource.TestCheckResourceAttrWith(resourceName, "redacted_value", func(redacted string) error {
+						if redacted == "" || !strings.HasSuffix(value, redacted) {
+							return fmt.Errorf("unexpected redacted value %q", redacted)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// A generated secret is set when the trigger changes
+				Config: testWebhookSigningSecretGeneratedConfig(spaceID, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_trigger", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "redacted_value", func(redacted string) error {
+						if redacted == "" || strings.HasSuffix(value, redacted) {
+							return fmt.Errorf("secret was not rotated, redacted value is %q", redacted)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateId:           spaceID,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+		},
+	})
+}
+
+func testAccCheckContentfulWebhookSigningSecretDestroy(s *terraform.State) error {
+	client := acctest.GetClient()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "contentful_webhook_signing_secret" {
+			continue
+		}
+
+		resp, err := client.GetWebhookSigningSecretWithResponse(context.Background(), rs.Primary.Attributes["space_id"])
+		if err != nil {
+			return err
+		}
+
+		if resp.StatusCode() == 404 {
+			return nil
+		}
+
+		return fmt.Errorf("webhook signing secret still exists for space: %s", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testWebhookSigningSecretConfig(spaceId string, value string, trigger string) string {
+	return fmt.Sprintf(`
+resource "contentful_webhook_signing_secret" "signing" {
+  space_id         = "%s"
+  value            = "%s"
+  rotation_trigger = "%s"
+}
+`, spaceId, value, trigger)
+}
+
+func testWebhookSigningSecretGeneratedConfig(spaceId string, trigger string) string {
+	return fmt.Sprintf(`
+resource "contentful_webhook_signing_secret" "signing" {
+  space_id         = "%s"
+  rotation_trigger = "%s"
+}
+`, spaceId, trigger)
+}
@@ -23,6 +23,16 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AnnotationLinkSysLinkType.
+const (
+	Annotation AnnotationLinkSysLinkType = "Annotation"
+)
+
+// Defines values for AnnotationLinkSysType.
+const (
+	AnnotationLinkSysTypeLink AnnotationLinkSysType = "Link"
+)
+
 // Defines values for ApiKeyPreviewApiKeySysType.
 const (
 	ApiKeyPreviewApiKeySysTypePreviewApiKey ApiKeyPreviewApiKeySysType = "PreviewApiKey"
@@ -93,6 +103,19 @@ const (
 	AssetCollectionSysTypeArray AssetCollectionSysType = "Array"
 )
 
+// Defines values for BulkActionSysStatus.
+const (
+	BulkActionSysStatusCreated    BulkActionSysStatus = "created"
+	BulkActionSysStatusFailed     BulkActionSysStatus = "failed"
+	BulkActionSysStatusInProgress BulkActionSysStatus = "inProgress"
+	BulkActionSysStatusSucceeded  BulkActionSysStatus = "succeeded"
+)
+
+// Defines values for BulkActionValidateDraftAction.
+const (
+	Publish BulkActionValidateDraftAction = "publish"
+)
+
 // Defines values for ContentTypeCollectionSysType.
 const (
 	ContentTypeCollectionSysTypeArray ContentTypeCollectionSysType = "Array"
@@ -147,16 +170,17 @@ const (
 
 // Defines values for FieldType.
 const (
-	FieldTypeArray    FieldType = "Array"
-	FieldTypeBoolean  FieldType = "Boolean"
-	FieldTypeDate     FieldType = "Date"
-	FieldTypeInteger  FieldType = "Integer"
-	FieldTypeLink     FieldType = "Link"
-	FieldTypeLocation FieldType = "Location"
-	FieldTypeNumber   FieldType = "Number"
-	FieldTypeObject   FieldType = "Object"
-	FieldTypeSymbol   FieldType = "Symbol"
-	FieldTypeText     FieldType = "Text"
+	FieldTypeArray        FieldType = "Array"
+	FieldTypeBoolean      FieldType = "Boolean"
+	FieldTypeDate         FieldType = "Date"
+	FieldTypeInteger      FieldType = "Integer"
+	FieldTypeLink         FieldType = "Link"
+	FieldTypeLocation     FieldType = "Location"
+	FieldTypeNumber       FieldType = "Number"
+	FieldTypeObject       FieldType = "Object"
+	FieldTypeResourceLink FieldType = "ResourceLink"
+	FieldTypeSymbol       FieldType = "Symbol"
+	FieldTypeText         FieldType = "Text"
 )
 
 // Defines values for FieldItemLinkLinkType.
@@ -170,11 +194,23 @@ const (
 	FieldItemLinkTypeLink FieldItemLinkType = "Link"
 )
 
+// Defines values for FieldItemResourceLinkType.
+const (
+	ResourceLink FieldItemResourceLinkType = "ResourceLink"
+)
+
 // Defines values for FieldItemSymbolType.
 const (
 	Symbol FieldItemSymbolType = "Symbol"
 )
 
+// Defines values for JsonPatchOperationOp.
+const (
+	Add     JsonPatchOperationOp = "add"
+	Remove  JsonPatchOperationOp = "remove"
+	Replace JsonPatchOperationOp = "replace"
+)
+
 // Defines values for LocaleCollectionSysType.
 const (
 	LocaleCollectionSysTypeArray LocaleCollectionSysType = "Array"
@@ -185,6 +221,14 @@ const (
 	PreviewApiKeyCollectionSysTypeArray PreviewApiKeyCollectionSysType = "Array"
 )
 
+// Defines values for ReleaseActionSysStatus.
+const (
+	ReleaseActionSysStatusCreated    ReleaseActionSysStatus = "created"
+	ReleaseActionSysStatusFailed     ReleaseActionSysStatus = "failed"
+	ReleaseActionSysStatusInProgress ReleaseActionSysStatus = "inProgress"
+	ReleaseActionSysStatusSucceeded  ReleaseActionSysStatus = "succeeded"
+)
+
 // Defines values for SpaceCollectionSysType.
 const (
 	SpaceCollectionSysTypeArray SpaceCollectionSysType = "Array"
@@ -197,7 +241,7 @@ const (
 
 // Defines values for SystemPropertiesContentTypeSysType.
 const (
-	Link SystemPropertiesContentTypeSysType = "Link"
+	SystemPropertiesContentTypeSysTypeLink SystemPropertiesContentTypeSysType = "Link"
 )
 
 // Defines values for SystemPropertiesPreviewEnvironmentType.
@@ -205,6 +249,17 @@ const (
 	SystemPropertiesPreviewEnvironmentTypePreviewEnvironment SystemPropertiesPreviewEnvironmentType = "PreviewEnvironment"
 )
 
+// Defines values for TaxonomyValidationSysLinkType.
+const (
+	TaxonomyConcept       TaxonomyValidationSysLinkType = "TaxonomyConcept"
+	TaxonomyConceptScheme TaxonomyValidationSysLinkType = "TaxonomyConceptScheme"
+)
+
+// Defines values for TaxonomyValidationSysType.
+const (
+	Link TaxonomyValidationSysType = "Link"
+)
+
 // Defines values for WebhookCollectionSysType.
 const (
 	WebhookCollectionSysTypeArray WebhookCollectionSysType = "Array"
@@ -213,10 +268,29 @@ const (
 // AllowedResource defines model for AllowedResource.
 type AllowedResource struct {
 	ContentTypes *[]string `json:"contentTypes,omitempty"`
-	Source       *string   `json:"source,omitempty"`
-	Type         *string   `json:"type,omitempty"`
+
+	// Source CRN of the space the resource belongs to, only used for Contentful resource types
+	Source *string `json:"source,omitempty"`
+
+	// Type Type of the resource, e.g. Contentful:Entry, Contentful:Asset or an external type provided by an app
+	Type *string `json:"type,omitempty"`
+}
+
+// AnnotationLink defines model for AnnotationLink.
+type AnnotationLink struct {
+	Sys struct {
+		Id       string                    `json:"id"`
+		LinkType AnnotationLinkSysLinkType `json:"linkType"`
+		Type     AnnotationLinkSysType     `json:"type"`
+	} `json:"sys"`
 }
 
+// AnnotationLinkSysLinkType defines model for AnnotationLink.Sys.LinkType.
+type AnnotationLinkSysLinkType string
+
+// AnnotationLinkSysType defines model for AnnotationLink.Sys.Type.
+type AnnotationLinkSysType string
+
 // ApiKey defines model for ApiKey.
 type ApiKey struct {
 	// AccessToken The Content Delivery API access token
@@ -503,7 +577,8 @@ type Asset struct {
 		// Title Asset title by locale
 		Title map[string]string `json:"title"`
 	} `json:"fields"`
-	Sys SystemPropertiesContent `json:"sys"`
+	Metadata *Metadata               `json:"metadata,omitempty"`
+	Sys      SystemPropertiesContent `json:"sys"`
 }
 
 // AssetCollection defines model for AssetCollection.
@@ -528,7 +603,8 @@ type AssetCollectionSysType string
 
 // AssetCreate defines model for AssetCreate.
 type AssetCreate struct {
-	Fields *AssetField `json:"fields,omitempty"`
+	Fields   *AssetField `json:"fields,omitempty"`
+	Metadata *Metadata   `json:"metadata,omitempty"`
 }
 
 // AssetField defines model for AssetField.
@@ -546,8 +622,11 @@ type AssetFile struct {
 	ContentType string `json:"contentType"`
 	FileName    string `json:"fileName"`
 
-	// Upload Upload URL
-	Upload string `json:"upload"`
+	// Upload Upload URL, the file is processed again when set
+	Upload *string `json:"upload,omitempty"`
+
+	// Url URL of the processed file, which is kept when no upload is set
+	Url *string `json:"url,omitempty"`
 }
 
 // AssetHyperlinkValidation defines model for AssetHyperlinkValidation.
@@ -556,20 +635,103 @@ type AssetHyperlinkValidation struct {
 	Size    *RangeMinMax `json:"size,omitempty"`
 }
 
+// BulkAction defines model for BulkAction.
+type BulkAction struct {
+	// Action The action of the bulk action, publish, unpublish or validate
+	Action string           `json:"action"`
+	Error  *BulkActionError `json:"error,omitempty"`
+	Sys    BulkActionSys    `json:"sys"`
+}
+
+// BulkActionDraft defines model for BulkActionDraft.
+type BulkActionDraft struct {
+	Entities BulkActionEntities `json:"entities"`
+}
+
+// BulkActionEntities defines model for BulkActionEntities.
+type BulkActionEntities struct {
+	// Items The entities of the bulk action, at most 200
+	Items []VersionedLink `json:"items"`
+	Sys   struct {
+		// Type Always Array
+		Type string `json:"type"`
+	} `json:"sys"`
+}
+
+// BulkActionEntityError defines model for BulkActionEntityError.
+type BulkActionEntityError struct {
+	Entity *VersionedLink `json:"entity,omitempty"`
+	Error  *struct {
+		Details *map[string]interface{} `json:"details,omitempty"`
+		Message *string                 `json:"message,omitempty"`
+		Sys     *struct {
+			// Id The type of error
+			Id *string `json:"id,omitempty"`
+		} `json:"sys,omitempty"`
+	} `json:"error,omitempty"`
+}
+
+// BulkActionError defines model for BulkActionError.
+type BulkActionError struct {
+	Details *struct {
+		Errors *[]BulkActionEntityError `json:"errors,omitempty"`
+	} `json:"details,omitempty"`
+	Message *string `json:"message,omitempty"`
+	Sys     *struct {
+		// Id The type of error
+		Id *string `json:"id,omitempty"`
+	} `json:"sys,omitempty"`
+}
+
+// BulkActionSys defines model for BulkActionSys.
+type BulkActionSys struct {
+	// Id ID of the bulk action
+	Id string `json:"id"`
+
+	// Status The status of the bulk action
+	Status BulkActionSysStatus `json:"status"`
+
+	// Type Always BulkAction
+	Type string `json:"type"`
+}
+
+// BulkActionSysStatus The status of the bulk action
+type BulkActionSysStatus string
+
+// BulkActionValidateDraft defines model for BulkActionValidateDraft.
+type BulkActionValidateDraft struct {
+	// Action The action to validate the entities for
+	Action   BulkActionValidateDraftAction `json:"action"`
+	Entities BulkActionEntities            `json:"entities"`
+}
+
+// BulkActionValidateDraftAction The action to validate the entities for
+type BulkActionValidateDraftAction string
+
 // ContentType defines model for ContentType.
 type ContentType struct {
 	// Description Description of the content type
 	Description *string `json:"description,omitempty"`
 
 	// DisplayField ID of the field to use as the display field
-	DisplayField *string `json:"displayField,omitempty"`
-	Fields       []Field `json:"fields"`
+	DisplayField *string              `json:"displayField,omitempty"`
+	Fields       []Field              `json:"fields"`
+	Metadata     *ContentTypeMetadata `json:"metadata,omitempty"`
 
 	// Name Name of the content type
 	Name string                   `json:"name"`
 	Sys  SystemPropertiesResource `json:"sys"`
 }
 
+// ContentTypeAnnotations defines model for ContentTypeAnnotations.
+type ContentTypeAnnotations struct {
+	// ContentType Annotations of the content type
+	ContentType *[]AnnotationLink `json:"ContentType,omitempty"`
+
+	// ContentTypeField Annotations of the fields, keyed by field id
+	ContentTypeField *map[string][]AnnotationLink `json:"ContentTypeField,omitempty"`
+}
+
 // ContentTypeCollection defines model for ContentTypeCollection.
 type ContentTypeCollection struct {
 	Items []ContentType `json:"items"`
@@ -596,21 +758,31 @@ type ContentTypeCreate struct {
 	Description *string `json:"description,omitempty"`
 
 	// DisplayField ID of the field to use as the display field
-	DisplayField *string `json:"displayField,omitempty"`
-	Fields       []Field `json:"fields"`
+	DisplayField *string              `json:"displayField,omitempty"`
+	Fields       []Field              `json:"fields"`
+	Metadata     *ContentTypeMetadata `json:"metadata,omitempty"`
 
 	// Name Name of the content type
 	Name string `json:"name"`
 }
 
+// ContentTypeMetadata defines model for ContentTypeMetadata.
+type ContentTypeMetadata struct {
+	Annotations *ContentTypeAnnotations `json:"annotations,omitempty"`
+
+	// Taxonomy Taxonomy concept schemes and concepts that can be assigned to entries of the content type
+	Taxonomy *[]TaxonomyValidation `json:"taxonomy,omitempty"`
+}
+
 // ContentTypeUpdate defines model for ContentTypeUpdate.
 type ContentTypeUpdate struct {
 	// Description Description of the content type
 	Description *string `json:"description,omitempty"`
 
 	// DisplayField ID of the field to use as the display field
-	DisplayField *string `json:"displayField,omitempty"`
-	Fields       []Field `json:"fields"`
+	DisplayField *string              `json:"displayField,omitempty"`
+	Fields       []Field              `json:"fields"`
+	Metadata     *ContentTypeMetadata `json:"metadata,omitempty"`
 
 	// Name Name of the content type
 	Name string `json:"name"`
@@ -769,8 +941,9 @@ type EmbeddedValidation struct {
 // Entry defines model for Entry.
 type Entry struct {
 	// Fields Content fields with values by locale
-	Fields orderedmap.OrderedMap `json:"fields"`
-	Sys    SystemPropertiesEntry `json:"sys"`
+	Fields   orderedmap.OrderedMap `json:"fields"`
+	Metadata *Metadata             `json:"metadata,omitempty"`
+	Sys      SystemPropertiesEntry `json:"sys"`
 }
 
 // EntryCollection defines model for EntryCollection.
@@ -796,7 +969,8 @@ type EntryCollectionSysType string
 // EntryDraft defines model for EntryDraft.
 type EntryDraft struct {
 	// Fields Content fields with values by locale
-	Fields *orderedmap.OrderedMap `json:"fields,omitempty"`
+	Fields   *orderedmap.OrderedMap `json:"fields,omitempty"`
+	Metadata *Metadata              `json:"metadata,omitempty"`
 }
 
 // EntryHyperlinkValidation defines model for EntryHyperlinkValidation.
@@ -806,6 +980,18 @@ type EntryHyperlinkValidation struct {
 	Size            *RangeMinMax `json:"size,omitempty"`
 }
 
+// EntryLocaleSelection defines model for EntryLocaleSelection.
+type EntryLocaleSelection struct {
+	// Fields The locales keyed by field, use `*` for all fields
+	Fields *map[string][]string `json:"fields,omitempty"`
+}
+
+// EntryPublishLocales The locales to publish and unpublish with locale based publishing
+type EntryPublishLocales struct {
+	Add    *EntryLocaleSelection `json:"add,omitempty"`
+	Remove *EntryLocaleSelection `json:"remove,omitempty"`
+}
+
 // Environment defines model for Environment.
 type Environment struct {
 	// Name Name of the environment
@@ -879,6 +1065,9 @@ type Error struct {
 
 // Field defines model for Field.
 type Field struct {
+	// AllowedResources For ResourceLink fields, defines the resources it can link to
+	AllowedResources *[]AllowedResource `json:"allowedResources,omitempty"`
+
 	// DefaultValue Default value for the field
 	DefaultValue *map[string]interface{} `json:"defaultValue,omitempty"`
 
@@ -935,6 +1124,16 @@ type FieldItemLinkLinkType string
 // FieldItemLinkType defines model for FieldItemLink.Type.
 type FieldItemLinkType string
 
+// FieldItemResourceLink defines model for FieldItemResourceLink.
+type FieldItemResourceLink struct {
+	AllowedResources []AllowedResource         `json:"allowedResources"`
+	Type             FieldItemResourceLinkType `json:"type"`
+	Validations      *[]FieldValidation        `json:"validations,omitempty"`
+}
+
+// FieldItemResourceLinkType defines model for FieldItemResourceLink.Type.
+type FieldItemResourceLinkType string
+
 // FieldItemSymbol defines model for FieldItemSymbol.
 type FieldItemSymbol struct {
 	Type        FieldItemSymbolType `json:"type"`
@@ -983,6 +1182,24 @@ type FieldValidation struct {
 	Unique *bool `json:"unique,omitempty"`
 }
 
+// JsonPatch A list of JSON patch operations as described in RFC 6902
+type JsonPatch = []JsonPatchOperation
+
+// JsonPatchOperation defines model for JsonPatchOperation.
+type JsonPatchOperation struct {
+	// Op The operation to perform
+	Op JsonPatchOperationOp `json:"op"`
+
+	// Path JSON pointer to the value the operation applies to, e.g. /fields/title/en-US
+	Path string `json:"path"`
+
+	// Value The value to add or replace, not used by remove
+	Value *interface{} `json:"value,omitempty"`
+}
+
+// JsonPatchOperationOp The operation to perform
+type JsonPatchOperationOp string
+
 // Locale defines model for Locale.
 type Locale struct {
 	// Code Locale code (e.g., en-US, de-DE)
@@ -1073,6 +1290,12 @@ type LocaleUpdate struct {
 	Optional *bool `json:"optional,omitempty"`
 }
 
+// Metadata defines model for Metadata.
+type Metadata struct {
+	// Tags Links to the tags of the entry or asset
+	Tags []SystemPropertiesReference `json:"tags"`
+}
+
 // NodesValidation defines model for NodesValidation.
 type NodesValidation struct {
 	AssetHyperlink         *[]AssetHyperlinkValidation       `json:"asset-hyperlink,omitempty"`
@@ -1201,6 +1424,59 @@ type RegexValidationValue struct {
 	Pattern string `json:"pattern"`
 }
 
+// Release defines model for Release.
+type Release struct {
+	Entities BulkActionEntities `json:"entities"`
+	Sys      ReleaseSys         `json:"sys"`
+
+	// Title Title of the release
+	Title string `json:"title"`
+}
+
+// ReleaseAction defines model for ReleaseAction.
+type ReleaseAction struct {
+	// Action The action of the release action, publish, unpublish or validate
+	Action string           `json:"action"`
+	Error  *BulkActionError `json:"error,omitempty"`
+	Sys    ReleaseActionSys `json:"sys"`
+}
+
+// ReleaseActionSys defines model for ReleaseActionSys.
+type ReleaseActionSys struct {
+	// Id ID of the release action
+	Id string `json:"id"`
+
+	// Status The status of the release action
+	Status ReleaseActionSysStatus `json:"status"`
+
+	// Type Always ReleaseAction
+	Type string `json:"type"`
+}
+
+// ReleaseActionSysStatus The status of the release action
+type ReleaseActionSysStatus string
+
+// ReleaseDraft defines model for ReleaseDraft.
+type ReleaseDraft struct {
+	Entities BulkActionEntities `json:"entities"`
+
+	// Title Title of the release
+	Title string `json:"title"`
+}
+
+// ReleaseSys defines model for ReleaseSys.
+type ReleaseSys struct {
+	// Id ID of the release
+	Id         string         `json:"id"`
+	LastAction *ReleaseAction `json:"lastAction,omitempty"`
+
+	// Type Always Release
+	Type string `json:"type"`
+
+	// Version Version of the release
+	Version int64 `json:"version"`
+}
+
 // ResourceHyperlinkValidation defines model for ResourceHyperlinkValidation.
 type ResourceHyperlinkValidation struct {
 	AllowedResources *[]AllowedResource    `json:"allowedResources,omitempty"`
@@ -1399,8 +1675,11 @@ type SystemPropertiesContent struct {
 	Id string `json:"id"`
 
 	// PublishedAt Publication timestamp
-	PublishedAt *time.Time                `json:"publishedAt,omitempty"`
-	Space       SystemPropertiesReference `json:"space"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+
+	// PublishedVersion The version of the resource that was last published
+	PublishedVersion *int64                    `json:"publishedVersion,omitempty"`
+	Space            SystemPropertiesReference `json:"space"`
 
 	// Type Resource type
 	Type string `json:"type"`
@@ -1421,12 +1700,18 @@ type SystemPropertiesEntry struct {
 	CreatedBy   SystemPropertiesReference  `json:"createdBy"`
 	Environment *SystemPropertiesReference `json:"environment,omitempty"`
 
+	// FieldStatus The publishing status (draft, changed or published) per locale, keyed by field or `*` for all fields
+	FieldStatus *map[string]map[string]string `json:"fieldStatus,omitempty"`
+
 	// Id Resource ID
 	Id string `json:"id"`
 
 	// PublishedAt Publication timestamp
-	PublishedAt *time.Time                `json:"publishedAt,omitempty"`
-	Space       SystemPropertiesReference `json:"space"`
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+
+	// PublishedVersion The version of the resource that was last published
+	PublishedVersion *int64                    `json:"publishedVersion,omitempty"`
+	Space            SystemPropertiesReference `json:"space"`
 
 	// Type Resource type
 	Type string `json:"type"`
@@ -1508,6 +1793,95 @@ type SystemPropertiesSpace struct {
 	Version int64 `json:"version"`
 }
 
+// Tag defines model for Tag.
+type Tag struct {
+	// Name Name of the tag
+	Name string `json:"name"`
+	Sys  TagSys `json:"sys"`
+}
+
+// TagCollection defines model for TagCollection.
+type TagCollection struct {
+	Items *[]Tag `json:"items,omitempty"`
+
+	// Limit Maximum number of tags returned
+	Limit *int `json:"limit,omitempty"`
+
+	// Skip Number of tags skipped
+	Skip *int `json:"skip,omitempty"`
+
+	// Total Total number of tags
+	Total *int `json:"total,omitempty"`
+}
+
+// TagDraft defines model for TagDraft.
+type TagDraft struct {
+	// Name Name of the tag
+	Name string `json:"name"`
+	Sys  struct {
+		// Id ID of the tag
+		Id string `json:"id"`
+
+		// Type Always Tag
+		Type string `json:"type"`
+
+		// Visibility Whether the tag is visible in the Content Delivery API, public or private
+		Visibility string `json:"visibility"`
+	} `json:"sys"`
+}
+
+// TagSys defines model for TagSys.
+type TagSys struct {
+	// Id ID of the tag
+	Id string `json:"id"`
+
+	// Type Always Tag
+	Type string `json:"type"`
+
+	// Version Version of the tag
+	Version int64 `json:"version"`
+
+	// Visibility Whether the tag is visible in the Content Delivery API, public or private
+	Visibility string `json:"visibility"`
+}
+
+// TaxonomyValidation defines model for TaxonomyValidation.
+type TaxonomyValidation struct {
+	// Required Whether entries need to be tagged with a concept of this validation
+	Required *bool `json:"required,omitempty"`
+	Sys      struct {
+		Id       string                        `json:"id"`
+		LinkType TaxonomyValidationSysLinkType `json:"linkType"`
+		Type     TaxonomyValidationSysType     `json:"type"`
+	} `json:"sys"`
+}
+
+// TaxonomyValidationSysLinkType defines model for TaxonomyValidation.Sys.LinkType.
+type TaxonomyValidationSysLinkType string
+
+// TaxonomyValidationSysType defines model for TaxonomyValidation.Sys.Type.
+type TaxonomyValidationSysType string
+
+// VersionedLink defines model for VersionedLink.
+type VersionedLink struct {
+	Sys VersionedLinkSys `json:"sys"`
+}
+
+// VersionedLinkSys defines model for VersionedLinkSys.
+type VersionedLinkSys struct {
+	// Id ID of the linked entity
+	Id string `json:"id"`
+
+	// LinkType The type of the linked entity, Entry or Asset
+	LinkType string `json:"linkType"`
+
+	// Type Always Link
+	Type string `json:"type"`
+
+	// Version The version of the linked entity
+	Version *int64 `json:"version,omitempty"`
+}
+
 // Webhook defines model for Webhook.
 type Webhook struct {
 	// Active Whether the webhook is active
@@ -1532,10 +1906,39 @@ type Webhook struct {
 	// Topics Events that trigger the webhook
 	Topics []string `json:"topics"`
 
+	// Transformation Transformation of the webhook request
+	Transformation *WebhookTransformation `json:"transformation,omitempty"`
+
 	// Url URL to call when the webhook is triggered
 	Url string `json:"url"`
 }
 
+// WebhookCallCollection defines model for WebhookCallCollection.
+type WebhookCallCollection struct {
+	Items []WebhookCallOverview `json:"items"`
+	Total *int                  `json:"total,omitempty"`
+}
+
+// WebhookCallOverview defines model for WebhookCallOverview.
+type WebhookCallOverview struct {
+	// Errors Errors which occurred while calling the webhook
+	Errors *[]string `json:"errors,omitempty"`
+
+	// EventType The action which triggered the call, e.g. publish
+	EventType  *string    `json:"eventType,omitempty"`
+	RequestAt  *time.Time `json:"requestAt,omitempty"`
+	ResponseAt *time.Time `json:"responseAt,omitempty"`
+
+	// StatusCode HTTP status code of the response, 0 when no response was received
+	StatusCode int `json:"statusCode"`
+	Sys        struct {
+		Id string `json:"id"`
+	} `json:"sys"`
+
+	// Url URL which was called
+	Url string `json:"url"`
+}
+
 // WebhookCollection defines model for WebhookCollection.
 type WebhookCollection struct {
 	Items *[]Webhook `json:"items,omitempty"`
@@ -1579,16 +1982,62 @@ type WebhookCreate struct {
 	// Topics Events that trigger the webhook
 	Topics []string `json:"topics"`
 
+	// Transformation Transformation of the webhook request
+	Transformation *WebhookTransformation `json:"transformation,omitempty"`
+
 	// Url URL to call when the webhook is triggered
 	Url string `json:"url"`
 }
 
 // WebhookHeader defines model for WebhookHeader.
 type WebhookHeader struct {
-	Key   string `json:"key"`
+	Key string `json:"key"`
+
+	// Secret Whether the value of the header is secret
+	Secret *bool `json:"secret,omitempty"`
+
+	// Value Value of the header, which is not returned for secret headers
+	Value *string `json:"value,omitempty"`
+}
+
+// WebhookHealth defines model for WebhookHealth.
+type WebhookHealth struct {
+	Calls struct {
+		// Healthy Number of recent calls with a successful response
+		Healthy int `json:"healthy"`
+
+		// Total Number of recent calls
+		Total int `json:"total"`
+	} `json:"calls"`
+}
+
+// WebhookSigningSecret defines model for WebhookSigningSecret.
+type WebhookSigningSecret struct {
+	// RedactedValue The last characters of the signing secret
+	RedactedValue string `json:"redactedValue"`
+}
+
+// WebhookSigningSecretUpdate defines model for WebhookSigningSecretUpdate.
+type WebhookSigningSecretUpdate struct {
+	// Value The signing secret, 64 characters of 0-9, a-z and A-Z
 	Value string `json:"value"`
 }
 
+// WebhookTransformation Transformation of the webhook request
+type WebhookTransformation struct {
+	// Body Custom JSON body of the request, which can contain JSON pointers like { /payload/sys/id }
+	Body *interface{} `json:"body,omitempty"`
+
+	// ContentType Content type of the request
+	ContentType *string `json:"contentType,omitempty"`
+
+	// IncludeContentLength Whether the Content-Length header is included in the request
+	IncludeContentLength *bool `json:"includeContentLength,omitempty"`
+
+	// Method HTTP method of the request
+	Method *string `json:"method,omitempty"`
+}
+
 // WebhookUpdate defines model for WebhookUpdate.
 type WebhookUpdate struct {
 	// Active Whether the webhook is active
@@ -1612,6 +2061,9 @@ type WebhookUpdate struct {
 	// Topics Events that trigger the webhook
 	Topics []string `json:"topics"`
 
+	// Transformation Transformation of the webhook request
+	Transformation *WebhookTransformation `json:"transformation,omitempty"`
+
 	// Url URL to call when the webhook is triggered
 	Url string `json:"url"`
 }
@@ -1643,6 +2095,9 @@ type LocaleId = string
 // OrganizationId defines model for organizationId.
 type OrganizationId = string
 
+// ReleaseId defines model for releaseId.
+type ReleaseId = string
+
 // ResourceId defines model for resourceId.
 type ResourceId = string
 
@@ -1658,6 +2113,9 @@ type Skip = int
 // SpaceId defines model for spaceId.
 type SpaceId = string
 
+// TagId defines model for tagId.
+type TagId = string
+
 // WebhookId defines model for webhookId.
 type WebhookId = string
 
@@ -1879,6 +2337,12 @@ type DeleteEntryParams struct {
 	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
 }
 
+// PatchEntryParams defines parameters for PatchEntry.
+type PatchEntryParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
 // UpdateEntryParams defines parameters for UpdateEntry.
 type UpdateEntryParams struct {
 	// XContentfulVersion The version of the locale to update.
@@ -1927,6 +2391,39 @@ type UpdateLocaleParams struct {
 	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
 }
 
+// UpdateReleaseParams defines parameters for UpdateRelease.
+type UpdateReleaseParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// PublishReleaseParams defines parameters for PublishRelease.
+type PublishReleaseParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// GetAllTagsParams defines parameters for GetAllTags.
+type GetAllTagsParams struct {
+	// Limit Maximum number of items to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Skip Number of items to skip
+	Skip *Skip `form:"skip,omitempty" json:"skip,omitempty"`
+}
+
+// DeleteTagParams defines parameters for DeleteTag.
+type DeleteTagParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
+// UpdateTagParams defines parameters for UpdateTag.
+type UpdateTagParams struct {
+	// XContentfulVersion The version of the locale to update.
+	XContentfulVersion ResourceVersion `json:"X-Contentful-Version"`
+}
+
 // GetAllPreviewApiKeysParams defines parameters for GetAllPreviewApiKeys.
 type GetAllPreviewApiKeysParams struct {
 	// Limit Maximum number of items to return
@@ -2029,6 +2526,15 @@ type CreateAssetJSONRequestBody = AssetCreate
 // UpdateAssetJSONRequestBody defines body for UpdateAsset for application/json ContentType.
 type UpdateAssetJSONRequestBody = AssetCreate
 
+// PublishBulkActionJSONRequestBody defines body for PublishBulkAction for application/json ContentType.
+type PublishBulkActionJSONRequestBody = BulkActionDraft
+
+// UnpublishBulkActionJSONRequestBody defines body for UnpublishBulkAction for application/json ContentType.
+type UnpublishBulkActionJSONRequestBody = BulkActionDraft
+
+// ValidateBulkActionJSONRequestBody defines body for ValidateBulkAction for application/json ContentType.
+type ValidateBulkActionJSONRequestBody = BulkActionValidateDraft
+
 // CreateContentTypeJSONRequestBody defines body for CreateContentType for application/json ContentType.
 type CreateContentTypeJSONRequestBody = ContentTypeCreate
 
@@ -2041,15 +2547,30 @@ type UpdateEditorInterfaceJSONRequestBody = EditorInterfaceUpdate
 // CreateEntryJSONRequestBody defines body for CreateEntry for application/json ContentType.
 type CreateEntryJSONRequestBody = EntryDraft
 
+// PatchEntryApplicationJSONPatchPlusJSONRequestBody defines body for PatchEntry for application/json-patch+json ContentType.
+type PatchEntryApplicationJSONPatchPlusJSONRequestBody = JsonPatch
+
 // UpdateEntryJSONRequestBody defines body for UpdateEntry for application/json ContentType.
 type UpdateEntryJSONRequestBody = EntryDraft
 
+// PublishEntryJSONRequestBody defines body for PublishEntry for application/json ContentType.
+type PublishEntryJSONRequestBody = EntryPublishLocales
+
 // CreateLocaleJSONRequestBody defines body for CreateLocale for application/json ContentType.
 type CreateLocaleJSONRequestBody = LocaleCreate
 
 // UpdateLocaleJSONRequestBody defines body for UpdateLocale for application/json ContentType.
 type UpdateLocaleJSONRequestBody = LocaleUpdate
 
+// CreateReleaseJSONRequestBody defines body for CreateRelease for application/json ContentType.
+type CreateReleaseJSONRequestBody = ReleaseDraft
+
+// UpdateReleaseJSONRequestBody defines body for UpdateRelease for application/json ContentType.
+type UpdateReleaseJSONRequestBody = ReleaseDraft
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = TagDraft
+
 // CreatePreviewEnvironmentJSONRequestBody defines body for CreatePreviewEnvironment for application/json ContentType.
 type CreatePreviewEnvironmentJSONRequestBody = PreviewEnvironmentInput
 
@@ -2068,6 +2589,9 @@ type CreateWebhookJSONRequestBody = WebhookCreate
 // UpdateWebhookJSONRequestBody defines body for UpdateWebhook for application/json ContentType.
 type UpdateWebhookJSONRequestBody = WebhookUpdate
 
+// UpdateWebhookSigningSecretJSONRequestBody defines body for UpdateWebhookSigningSecret for application/json ContentType.
+type UpdateWebhookSigningSecretJSONRequestBody = WebhookSigningSecretUpdate
+
 // Getter for additional properties for EditorInterfaceSettings. Returns the specified
 // element and whether it was found
 func (a EditorInterfaceSettings) Get(fieldName string) (value interface{}, found bool) {
@@ -2297,6 +2821,34 @@ func (t *FieldItem) MergeFieldItemLink(v FieldItemLink) error {
 	return err
 }
 
+// AsFieldItemResourceLink returns the union data inside the FieldItem as a FieldItemResourceLink
+func (t FieldItem) AsFieldItemResourceLink() (FieldItemResourceLink, error) {
+	var body FieldItemResourceLink
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFieldItemResourceLink overwrites any union data inside the FieldItem as the provided FieldItemResourceLink
+func (t *FieldItem) FromFieldItemResourceLink(v FieldItemResourceLink) error {
+	v.Type = "ResourceLink"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFieldItemResourceLink performs a merge with any union data inside the FieldItem, using the provided FieldItemResourceLink
+func (t *FieldItem) MergeFieldItemResourceLink(v FieldItemResourceLink) error {
+	v.Type = "ResourceLink"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t FieldItem) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -2313,6 +2865,8 @@ func (t FieldItem) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "Link":
 		return t.AsFieldItemLink()
+	case "ResourceLink":
+		return t.AsFieldItemResourceLink()
 	case "Symbol":
 		return t.AsFieldItemSymbol()
 	default:
@@ -2546,6 +3100,24 @@ type ClientInterface interface {
 	// PublishAsset request
 	PublishAsset(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetBulkAction request
+	GetBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, bulkActionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishBulkActionWithBody request with any body
+	PublishBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PublishBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body PublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnpublishBulkActionWithBody request with any body
+	UnpublishBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UnpublishBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body UnpublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ValidateBulkActionWithBody request with any body
+	ValidateBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ValidateBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body ValidateBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetAllContentTypes request
 	GetAllContentTypes(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2593,6 +3165,11 @@ type ClientInterface interface {
 	// GetEntry request
 	GetEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PatchEntryWithBody request with any body
+	PatchEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PatchEntryWithApplicationJSONPatchPlusJSONBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, body PatchEntryApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// UpdateEntryWithBody request with any body
 	UpdateEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2607,8 +3184,10 @@ type ClientInterface interface {
 	// UnpublishEntry request
 	UnpublishEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// PublishEntry request
-	PublishEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PublishEntryWithBody request with any body
+	PublishEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PublishEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, body PublishEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetAllLocales request
 	GetAllLocales(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -2629,24 +3208,60 @@ type ClientInterface interface {
 
 	UpdateLocale(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetAllPreviewApiKeys request
-	GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// CreateReleaseWithBody request with any body
+	CreateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetPreviewApiKey request
-	GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	CreateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// CreatePreviewEnvironmentWithBody request with any body
-	CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// DeleteRelease request
+	DeleteRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// GetRelease request
+	GetRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// DeletePreviewEnvironment request
-	DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// UpdateReleaseWithBody request with any body
+	UpdateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// GetPreviewEnvironment request
-	GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+	UpdateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// UpdatePreviewEnvironmentWithBody request with any body
+	// GetReleaseAction request
+	GetReleaseAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishRelease request
+	PublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllTags request
+	GetAllTags(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteTag request
+	DeleteTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTag request
+	GetTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTagWithBody request with any body
+	UpdateTagWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAllPreviewApiKeys request
+	GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPreviewApiKey request
+	GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreatePreviewEnvironmentWithBody request with any body
+	CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeletePreviewEnvironment request
+	DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPreviewEnvironment request
+	GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdatePreviewEnvironmentWithBody request with any body
 	UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -2688,6 +3303,23 @@ type ClientInterface interface {
 	UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhookSigningSecret request
+	DeleteWebhookSigningSecret(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookSigningSecret request
+	GetWebhookSigningSecret(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateWebhookSigningSecretWithBody request with any body
+	UpdateWebhookSigningSecretWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateWebhookSigningSecret(ctx context.Context, spaceId SpaceId, body UpdateWebhookSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookCalls request
+	GetWebhookCalls(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookHealth request
+	GetWebhookHealth(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAllAppDefinitions(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -3314,6 +3946,90 @@ func (c *Client) PublishAsset(ctx context.Context, spaceId SpaceId, environmentI
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, bulkActionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBulkActionRequest(c.Server, spaceId, environmentId, bulkActionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishBulkActionRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body PublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishBulkActionRequest(c.Server, spaceId, environmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnpublishBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpublishBulkActionRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnpublishBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body UnpublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnpublishBulkActionRequest(c.Server, spaceId, environmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateBulkActionWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateBulkActionRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ValidateBulkAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body ValidateBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewValidateBulkActionRequest(c.Server, spaceId, environmentId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetAllContentTypes(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetAllContentTypesRequest(c.Server, spaceId, environmentId, params)
 	if err != nil {
@@ -3518,6 +4234,30 @@ func (c *Client) GetEntry(ctx context.Context, spaceId SpaceId, environmentId En
 	return c.Client.Do(req)
 }
 
+func (c *Client) PatchEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEntryRequestWithBody(c.Server, spaceId, environmentId, entryId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PatchEntryWithApplicationJSONPatchPlusJSONBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, body PatchEntryApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPatchEntryRequestWithApplicationJSONPatchPlusJSONBody(c.Server, spaceId, environmentId, entryId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) UpdateEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewUpdateEntryRequestWithBody(c.Server, spaceId, environmentId, entryId, params, contentType, body)
 	if err != nil {
@@ -3578,8 +4318,20 @@ func (c *Client) UnpublishEntry(ctx context.Context, spaceId SpaceId, environmen
 	return c.Client.Do(req)
 }
 
-func (c *Client) PublishEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPublishEntryRequest(c.Server, spaceId, environmentId, entryId, params)
+func (c *Client) PublishEntryWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishEntryRequestWithBody(c.Server, spaceId, environmentId, entryId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishEntry(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, body PublishEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishEntryRequest(c.Server, spaceId, environmentId, entryId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3674,8 +4426,8 @@ func (c *Client) UpdateLocale(ctx context.Context, spaceId SpaceId, environmentI
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllPreviewApiKeysRequest(c.Server, spaceId, params)
+func (c *Client) CreateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReleaseRequestWithBody(c.Server, spaceId, environmentId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3686,8 +4438,8 @@ func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, para
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPreviewApiKeyRequest(c.Server, spaceId, resourceId)
+func (c *Client) CreateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateReleaseRequest(c.Server, spaceId, environmentId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3698,8 +4450,8 @@ func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resource
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePreviewEnvironmentRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) DeleteRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteReleaseRequest(c.Server, spaceId, environmentId, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3710,8 +4462,8 @@ func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId S
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreatePreviewEnvironmentRequest(c.Server, spaceId, body)
+func (c *Client) GetRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReleaseRequest(c.Server, spaceId, environmentId, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -3722,8 +4474,8 @@ func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeletePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params)
+func (c *Client) UpdateReleaseWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseRequestWithBody(c.Server, spaceId, environmentId, releaseId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3734,8 +4486,8 @@ func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetPreviewEnvironmentRequest(c.Server, spaceId, resourceId)
+func (c *Client) UpdateRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateReleaseRequest(c.Server, spaceId, environmentId, releaseId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3746,8 +4498,8 @@ func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, res
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePreviewEnvironmentRequestWithBody(c.Server, spaceId, resourceId, params, contentType, body)
+func (c *Client) GetReleaseAction(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetReleaseActionRequest(c.Server, spaceId, environmentId, releaseId, releaseActionId)
 	if err != nil {
 		return nil, err
 	}
@@ -3758,8 +4510,8 @@ func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId S
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdatePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params, body)
+func (c *Client) PublishRelease(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishReleaseRequest(c.Server, spaceId, environmentId, releaseId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3770,8 +4522,8 @@ func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllRolesRequest(c.Server, spaceId, params)
+func (c *Client) GetAllTags(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllTagsRequest(c.Server, spaceId, environmentId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3782,8 +4534,8 @@ func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAl
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRoleRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) DeleteTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteTagRequest(c.Server, spaceId, environmentId, tagId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3794,8 +4546,8 @@ func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, conten
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRoleRequest(c.Server, spaceId, body)
+func (c *Client) GetTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTagRequest(c.Server, spaceId, environmentId, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -3806,8 +4558,8 @@ func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRol
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteRoleRequest(c.Server, spaceId, roleId, params)
+func (c *Client) UpdateTagWithBody(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequestWithBody(c.Server, spaceId, environmentId, tagId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3818,8 +4570,8 @@ func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRoleRequest(c.Server, spaceId, roleId)
+func (c *Client) UpdateTag(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTagRequest(c.Server, spaceId, environmentId, tagId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3830,8 +4582,8 @@ func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRoleRequestWithBody(c.Server, spaceId, roleId, params, contentType, body)
+func (c *Client) GetAllPreviewApiKeys(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllPreviewApiKeysRequest(c.Server, spaceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3842,8 +4594,8 @@ func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateRoleRequest(c.Server, spaceId, roleId, params, body)
+func (c *Client) GetPreviewApiKey(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreviewApiKeyRequest(c.Server, spaceId, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -3854,8 +4606,8 @@ func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId,
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetAllWebhooksRequest(c.Server, spaceId, params)
+func (c *Client) CreatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePreviewEnvironmentRequestWithBody(c.Server, spaceId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3866,8 +4618,8 @@ func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *Ge
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateWebhookRequestWithBody(c.Server, spaceId, contentType, body)
+func (c *Client) CreatePreviewEnvironment(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreatePreviewEnvironmentRequest(c.Server, spaceId, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3878,8 +4630,8 @@ func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, con
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateWebhookRequest(c.Server, spaceId, body)
+func (c *Client) DeletePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeletePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3890,8 +4642,8 @@ func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body Create
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWebhookRequest(c.Server, spaceId, webhookId, params)
+func (c *Client) GetPreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPreviewEnvironmentRequest(c.Server, spaceId, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -3902,8 +4654,8 @@ func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId W
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWebhookRequest(c.Server, spaceId, webhookId)
+func (c *Client) UpdatePreviewEnvironmentWithBody(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreviewEnvironmentRequestWithBody(c.Server, spaceId, resourceId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3914,8 +4666,8 @@ func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId Webh
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWebhookRequestWithBody(c.Server, spaceId, webhookId, params, contentType, body)
+func (c *Client) UpdatePreviewEnvironment(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdatePreviewEnvironmentRequest(c.Server, spaceId, resourceId, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -3926,8 +4678,8 @@ func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, web
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateWebhookRequest(c.Server, spaceId, webhookId, params, body)
+func (c *Client) GetAllRoles(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllRolesRequest(c.Server, spaceId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -3938,39 +4690,267 @@ func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId W
 	return c.Client.Do(req)
 }
 
-// NewGetAllAppDefinitionsRequest generates requests for GetAllAppDefinitions
-func NewGetAllAppDefinitionsRequest(server string, organizationId OrganizationId, params *GetAllAppDefinitionsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+func (c *Client) CreateRoleWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequestWithBody(c.Server, spaceId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateRole(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRoleRequest(c.Server, spaceId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/organizations/%s/app_definitions", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeleteRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRoleRequest(c.Server, spaceId, roleId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) GetRole(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRoleRequest(c.Server, spaceId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Limit != nil {
+func (c *Client) UpdateRoleWithBody(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequestWithBody(c.Server, spaceId, roleId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
+func (c *Client) UpdateRole(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateRoleRequest(c.Server, spaceId, roleId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAllWebhooks(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAllWebhooksRequest(c.Server, spaceId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, spaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, spaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, spaceId, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookRequest(c.Server, spaceId, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookWithBody(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequestWithBody(c.Server, spaceId, webhookId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhook(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookRequest(c.Server, spaceId, webhookId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhookSigningSecret(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookSigningSecretRequest(c.Server, spaceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookSigningSecret(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookSigningSecretRequest(c.Server, spaceId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookSigningSecretWithBody(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookSigningSecretRequestWithBody(c.Server, spaceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateWebhookSigningSecret(ctx context.Context, spaceId SpaceId, body UpdateWebhookSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateWebhookSigningSecretRequest(c.Server, spaceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookCalls(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookCallsRequest(c.Server, spaceId, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookHealth(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookHealthRequest(c.Server, spaceId, webhookId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAllAppDefinitionsRequest generates requests for GetAllAppDefinitions
+func NewGetAllAppDefinitionsRequest(server string, organizationId OrganizationId, params *GetAllAppDefinitionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/app_definitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
 			} else {
@@ -6145,8 +7125,8 @@ func NewPublishAssetRequest(server string, spaceId SpaceId, environmentId Enviro
 	return req, nil
 }
 
-// NewGetAllContentTypesRequest generates requests for GetAllContentTypes
-func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams) (*http.Request, error) {
+// NewGetBulkActionRequest generates requests for GetBulkAction
+func NewGetBulkActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, bulkActionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6163,12 +7143,19 @@ func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "bulkActionId", runtime.ParamLocationPath, bulkActionId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/actions/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6178,44 +7165,6 @@ func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Skip != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -6224,19 +7173,19 @@ func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId
 	return req, nil
 }
 
-// NewCreateContentTypeRequest calls the generic CreateContentType builder with application/json body
-func NewCreateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody) (*http.Request, error) {
+// NewPublishBulkActionRequest calls the generic PublishBulkAction builder with application/json body
+func NewPublishBulkActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body PublishBulkActionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateContentTypeRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+	return NewPublishBulkActionRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
 }
 
-// NewCreateContentTypeRequestWithBody generates requests for CreateContentType with any type of body
-func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+// NewPublishBulkActionRequestWithBody generates requests for PublishBulkAction with any type of body
+func NewPublishBulkActionRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6258,7 +7207,7 @@ func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/publish", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -6278,8 +7227,19 @@ func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environ
 	return req, nil
 }
 
-// NewDeleteContentTypeRequest generates requests for DeleteContentType
-func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams) (*http.Request, error) {
+// NewUnpublishBulkActionRequest calls the generic UnpublishBulkAction builder with application/json body
+func NewUnpublishBulkActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body UnpublishBulkActionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUnpublishBulkActionRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+}
+
+// NewUnpublishBulkActionRequestWithBody generates requests for UnpublishBulkAction with any type of body
+func NewUnpublishBulkActionRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -6296,9 +7256,239 @@ func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId E
 		return nil, err
 	}
 
-	var pathParam2 string
-
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/unpublish", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewValidateBulkActionRequest calls the generic ValidateBulkAction builder with application/json body
+func NewValidateBulkActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body ValidateBulkActionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewValidateBulkActionRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+}
+
+// NewValidateBulkActionRequestWithBody generates requests for ValidateBulkAction with any type of body
+func NewValidateBulkActionRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/bulk_actions/validate", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAllContentTypesRequest generates requests for GetAllContentTypes
+func NewGetAllContentTypesRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateContentTypeRequest calls the generic CreateContentType builder with application/json body
+func NewCreateContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateContentTypeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateContentTypeRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+}
+
+// NewCreateContentTypeRequestWithBody generates requests for CreateContentType with any type of body
+func NewCreateContentTypeRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/content_types", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteContentTypeRequest generates requests for DeleteContentType
+func NewDeleteContentTypeRequest(server string, spaceId SpaceId, environmentId EnvironmentId, contentTypeId ContentTypeId, params *DeleteContentTypeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "contentTypeId", runtime.ParamLocationPath, contentTypeId)
 	if err != nil {
 		return nil, err
 	}
@@ -6976,19 +8166,19 @@ func NewGetEntryRequest(server string, spaceId SpaceId, environmentId Environmen
 	return req, nil
 }
 
-// NewUpdateEntryRequest calls the generic UpdateEntry builder with application/json body
-func NewUpdateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody) (*http.Request, error) {
+// NewPatchEntryRequestWithApplicationJSONPatchPlusJSONBody calls the generic PatchEntry builder with application/json-patch+json body
+func NewPatchEntryRequestWithApplicationJSONPatchPlusJSONBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, body PatchEntryApplicationJSONPatchPlusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json", bodyReader)
+	return NewPatchEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json-patch+json", bodyReader)
 }
 
-// NewUpdateEntryRequestWithBody generates requests for UpdateEntry with any type of body
-func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPatchEntryRequestWithBody generates requests for PatchEntry with any type of body
+func NewPatchEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7027,7 +8217,7 @@ func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -7045,22 +8235,24 @@ func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId
 
 		req.Header.Set("X-Contentful-Version", headerParam0)
 
-		var headerParam1 string
-
-		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Contentful-Content-Type", headerParam1)
-
 	}
 
 	return req, nil
 }
 
-// NewUnarchiveEntryRequest generates requests for UnarchiveEntry
-func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams) (*http.Request, error) {
+// NewUpdateEntryRequest calls the generic UpdateEntry builder with application/json body
+func NewUpdateEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, body UpdateEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json", bodyReader)
+}
+
+// NewUpdateEntryRequestWithBody generates requests for UpdateEntry with any type of body
+func NewUpdateEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7089,7 +8281,79 @@ func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId Envi
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+		var headerParam1 string
+
+		headerParam1, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Content-Type", runtime.ParamLocationHeader, params.XContentfulContentType)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Content-Type", headerParam1)
+
+	}
+
+	return req, nil
+}
+
+// NewUnarchiveEntryRequest generates requests for UnarchiveEntry
+func NewUnarchiveEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnarchiveEntryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "entryId", runtime.ParamLocationPath, entryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/entries/%s/archived", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7242,8 +8506,19 @@ func NewUnpublishEntryRequest(server string, spaceId SpaceId, environmentId Envi
 	return req, nil
 }
 
-// NewPublishEntryRequest generates requests for PublishEntry
-func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams) (*http.Request, error) {
+// NewPublishEntryRequest calls the generic PublishEntry builder with application/json body
+func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, body PublishEntryJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPublishEntryRequestWithBody(server, spaceId, environmentId, entryId, params, "application/json", bodyReader)
+}
+
+// NewPublishEntryRequestWithBody generates requests for PublishEntry with any type of body
+func NewPublishEntryRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7282,11 +8557,13 @@ func NewPublishEntryRequest(server string, spaceId SpaceId, environmentId Enviro
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		var headerParam0 string
@@ -7606,8 +8883,19 @@ func NewUpdateLocaleRequestWithBody(server string, spaceId SpaceId, environmentI
 	return req, nil
 }
 
-// NewGetAllPreviewApiKeysRequest generates requests for GetAllPreviewApiKeys
-func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetAllPreviewApiKeysParams) (*http.Request, error) {
+// NewCreateReleaseRequest calls the generic CreateRelease builder with application/json body
+func NewCreateReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateReleaseRequestWithBody(server, spaceId, environmentId, "application/json", bodyReader)
+}
+
+// NewCreateReleaseRequestWithBody generates requests for CreateRelease with any type of body
+func NewCreateReleaseRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7617,12 +8905,19 @@ func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetA
 		return nil, err
 	}
 
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7632,54 +8927,18 @@ func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetA
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Limit != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Skip != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetPreviewApiKeyRequest generates requests for GetPreviewApiKey
-func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+// NewDeleteReleaseRequest generates requests for DeleteRelease
+func NewDeleteReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7691,7 +8950,14 @@ func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId Resou
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -7701,7 +8967,7 @@ func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId Resou
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7711,7 +8977,7 @@ func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId Resou
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -7719,24 +8985,27 @@ func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId Resou
 	return req, nil
 }
 
-// NewCreatePreviewEnvironmentRequest calls the generic CreatePreviewEnvironment builder with application/json body
-func NewCreatePreviewEnvironmentRequest(server string, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewGetReleaseRequest generates requests for GetRelease
+func NewGetReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreatePreviewEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
-}
 
-// NewCreatePreviewEnvironmentRequestWithBody generates requests for CreatePreviewEnvironment with any type of body
-func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
 
-	var pathParam0 string
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -7746,7 +9015,7 @@ func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7756,18 +9025,27 @@ func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewDeletePreviewEnvironmentRequest generates requests for DeletePreviewEnvironment
-func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams) (*http.Request, error) {
+// NewUpdateReleaseRequest calls the generic UpdateRelease builder with application/json body
+func NewUpdateReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateReleaseRequestWithBody(server, spaceId, environmentId, releaseId, params, "application/json", bodyReader)
+}
+
+// NewUpdateReleaseRequestWithBody generates requests for UpdateRelease with any type of body
+func NewUpdateReleaseRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7779,7 +9057,14 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -7789,7 +9074,7 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7799,11 +9084,13 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	if params != nil {
 
 		var headerParam0 string
@@ -7820,8 +9107,8 @@ func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resource
 	return req, nil
 }
 
-// NewGetPreviewEnvironmentRequest generates requests for GetPreviewEnvironment
-func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+// NewGetReleaseActionRequest generates requests for GetReleaseAction
+func NewGetReleaseActionRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId string) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7833,7 +9120,21 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "releaseActionId", runtime.ParamLocationPath, releaseActionId)
 	if err != nil {
 		return nil, err
 	}
@@ -7843,7 +9144,7 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/actions/%s", pathParam0, pathParam1, pathParam2, pathParam3)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7861,19 +9162,8 @@ func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId
 	return req, nil
 }
 
-// NewUpdatePreviewEnvironmentRequest calls the generic UpdatePreviewEnvironment builder with application/json body
-func NewUpdatePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdatePreviewEnvironmentRequestWithBody(server, spaceId, resourceId, params, "application/json", bodyReader)
-}
-
-// NewUpdatePreviewEnvironmentRequestWithBody generates requests for UpdatePreviewEnvironment with any type of body
-func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPublishReleaseRequest generates requests for PublishRelease
+func NewPublishReleaseRequest(server string, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7885,7 +9175,14 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "releaseId", runtime.ParamLocationPath, releaseId)
 	if err != nil {
 		return nil, err
 	}
@@ -7895,7 +9192,7 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/releases/%s/published", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -7905,13 +9202,11 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	if params != nil {
 
 		var headerParam0 string
@@ -7928,8 +9223,8 @@ func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId,
 	return req, nil
 }
 
-// NewGetAllRolesRequest generates requests for GetAllRoles
-func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesParams) (*http.Request, error) {
+// NewGetAllTagsRequest generates requests for GetAllTags
+func NewGetAllTagsRequest(server string, spaceId SpaceId, environmentId EnvironmentId, params *GetAllTagsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -7939,12 +9234,19 @@ func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesPa
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/tags", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8000,19 +9302,8 @@ func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesPa
 	return req, nil
 }
 
-// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
-func NewCreateRoleRequest(server string, spaceId SpaceId, body CreateRoleJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRoleRequestWithBody(server, spaceId, "application/json", bodyReader)
-}
-
-// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
-func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeleteTagRequest generates requests for DeleteTag
+func NewDeleteTagRequest(server string, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *DeleteTagParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8022,45 +9313,16 @@ func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType st
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewDeleteRoleRequest generates requests for DeleteRole
-func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+	var pathParam1 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	var pathParam2 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -8070,7 +9332,7 @@ func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8101,8 +9363,8 @@ func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params
 	return req, nil
 }
 
-// NewGetRoleRequest generates requests for GetRole
-func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Request, error) {
+// NewGetTagRequest generates requests for GetTag
+func NewGetTagRequest(server string, spaceId SpaceId, environmentId EnvironmentId, tagId TagId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8114,7 +9376,14 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -8124,7 +9393,7 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8142,19 +9411,19 @@ func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Req
 	return req, nil
 }
 
-// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
-func NewUpdateRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+// NewUpdateTagRequest calls the generic UpdateTag builder with application/json body
+func NewUpdateTagRequest(server string, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, body UpdateTagJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateRoleRequestWithBody(server, spaceId, roleId, params, "application/json", bodyReader)
+	return NewUpdateTagRequestWithBody(server, spaceId, environmentId, tagId, params, "application/json", bodyReader)
 }
 
-// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
-func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateTagRequestWithBody generates requests for UpdateTag with any type of body
+func NewUpdateTagRequestWithBody(server string, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8166,7 +9435,14 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "environmentId", runtime.ParamLocationPath, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "tagId", runtime.ParamLocationPath, tagId)
 	if err != nil {
 		return nil, err
 	}
@@ -8176,7 +9452,7 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/environments/%s/tags/%s", pathParam0, pathParam1, pathParam2)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8209,8 +9485,8 @@ func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId,
 	return req, nil
 }
 
-// NewGetAllWebhooksRequest generates requests for GetAllWebhooks
-func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebhooksParams) (*http.Request, error) {
+// NewGetAllPreviewApiKeysRequest generates requests for GetAllPreviewApiKeys
+func NewGetAllPreviewApiKeysRequest(server string, spaceId SpaceId, params *GetAllPreviewApiKeysParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8225,7 +9501,7 @@ func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebh
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8281,19 +9557,60 @@ func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebh
 	return req, nil
 }
 
-// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
-func NewCreateWebhookRequest(server string, spaceId SpaceId, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+// NewGetPreviewApiKeyRequest generates requests for GetPreviewApiKey
+func NewGetPreviewApiKeyRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/preview_api_keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreatePreviewEnvironmentRequest calls the generic CreatePreviewEnvironment builder with application/json body
+func NewCreatePreviewEnvironmentRequest(server string, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateWebhookRequestWithBody(server, spaceId, "application/json", bodyReader)
+	return NewCreatePreviewEnvironmentRequestWithBody(server, spaceId, "application/json", bodyReader)
 }
 
-// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
-func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreatePreviewEnvironmentRequestWithBody generates requests for CreatePreviewEnvironment with any type of body
+func NewCreatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8308,7 +9625,7 @@ func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8328,8 +9645,8 @@ func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType
 	return req, nil
 }
 
-// NewDeleteWebhookRequest generates requests for DeleteWebhook
-func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams) (*http.Request, error) {
+// NewDeletePreviewEnvironmentRequest generates requests for DeletePreviewEnvironment
+func NewDeletePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8341,7 +9658,7 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -8351,7 +9668,7 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8382,8 +9699,8 @@ func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId
 	return req, nil
 }
 
-// NewGetWebhookRequest generates requests for GetWebhook
-func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+// NewGetPreviewEnvironmentRequest generates requests for GetPreviewEnvironment
+func NewGetPreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8395,7 +9712,7 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -8405,7 +9722,7 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8423,19 +9740,19 @@ func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (
 	return req, nil
 }
 
-// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
-func NewUpdateWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+// NewUpdatePreviewEnvironmentRequest calls the generic UpdatePreviewEnvironment builder with application/json body
+func NewUpdatePreviewEnvironmentRequest(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateWebhookRequestWithBody(server, spaceId, webhookId, params, "application/json", bodyReader)
+	return NewUpdatePreviewEnvironmentRequestWithBody(server, spaceId, resourceId, params, "application/json", bodyReader)
 }
 
-// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
-func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdatePreviewEnvironmentRequestWithBody generates requests for UpdatePreviewEnvironment with any type of body
+func NewUpdatePreviewEnvironmentRequestWithBody(server string, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -8447,7 +9764,7 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 
 	var pathParam1 string
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "resourceId", runtime.ParamLocationPath, resourceId)
 	if err != nil {
 		return nil, err
 	}
@@ -8457,7 +9774,7 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/spaces/%s/preview_environments/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -8490,72 +9807,831 @@ func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId W
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetAllRolesRequest generates requests for GetAllRoles
+func NewGetAllRolesRequest(server string, spaceId SpaceId, params *GetAllRolesParams) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAllAppDefinitionsWithResponse request
-	GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error)
 
-	// CreateAppDefinitionWithBodyWithResponse request with any body
-	CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteAppDefinitionWithResponse request
-	DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// GetAppDefinitionWithResponse request
-	GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error)
+		if params.Limit != nil {
 
-	// UpdateAppDefinitionWithBodyWithResponse request with any body
-	UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+		}
 
-	// CreateAppBundleWithBodyWithResponse request with any body
-	CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+		if params.Skip != nil {
 
-	CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRoleRequest calls the generic CreateRole builder with application/json body
+func NewCreateRoleRequest(server string, spaceId SpaceId, body CreateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRoleRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewCreateRoleRequestWithBody generates requests for CreateRole with any type of body
+func NewCreateRoleRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRoleRequest generates requests for DeleteRole
+func NewDeleteRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetRoleRequest generates requests for GetRole
+func NewGetRoleRequest(server string, spaceId SpaceId, roleId RoleId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateRoleRequest calls the generic UpdateRole builder with application/json body
+func NewUpdateRoleRequest(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateRoleRequestWithBody(server, spaceId, roleId, params, "application/json", bodyReader)
+}
+
+// NewUpdateRoleRequestWithBody generates requests for UpdateRole with any type of body
+func NewUpdateRoleRequestWithBody(server string, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "roleId", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAllWebhooksRequest generates requests for GetAllWebhooks
+func NewGetAllWebhooksRequest(server string, spaceId SpaceId, params *GetAllWebhooksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Skip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "skip", runtime.ParamLocationQuery, *params.Skip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, spaceId SpaceId, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookRequest generates requests for GetWebhook
+func NewGetWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookRequest calls the generic UpdateWebhook builder with application/json body
+func NewUpdateWebhookRequest(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookRequestWithBody(server, spaceId, webhookId, params, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookRequestWithBody generates requests for UpdateWebhook with any type of body
+func NewUpdateWebhookRequestWithBody(server string, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Contentful-Version", runtime.ParamLocationHeader, params.XContentfulVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Contentful-Version", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWebhookSigningSecretRequest generates requests for DeleteWebhookSigningSecret
+func NewDeleteWebhookSigningSecretRequest(server string, spaceId SpaceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_settings/signing_secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookSigningSecretRequest generates requests for GetWebhookSigningSecret
+func NewGetWebhookSigningSecretRequest(server string, spaceId SpaceId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_settings/signing_secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateWebhookSigningSecretRequest calls the generic UpdateWebhookSigningSecret builder with application/json body
+func NewUpdateWebhookSigningSecretRequest(server string, spaceId SpaceId, body UpdateWebhookSigningSecretJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateWebhookSigningSecretRequestWithBody(server, spaceId, "application/json", bodyReader)
+}
+
+// NewUpdateWebhookSigningSecretRequestWithBody generates requests for UpdateWebhookSigningSecret with any type of body
+func NewUpdateWebhookSigningSecretRequestWithBody(server string, spaceId SpaceId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhook_settings/signing_secret", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWebhookCallsRequest generates requests for GetWebhookCalls
+func NewGetWebhookCallsRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhooks/%s/calls", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookHealthRequest generates requests for GetWebhookHealth
+func NewGetWebhookHealthRequest(server string, spaceId SpaceId, webhookId WebhookId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "spaceId", runtime.ParamLocationPath, spaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/spaces/%s/webhooks/%s/health", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllAppDefinitionsWithResponse request
+	GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error)
+
+	// CreateAppDefinitionWithBodyWithResponse request with any body
+	CreateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+
+	CreateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, body CreateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppDefinitionResponse, error)
+
+	// DeleteAppDefinitionWithResponse request
+	DeleteAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *DeleteAppDefinitionParams, reqEditors ...RequestEditorFn) (*DeleteAppDefinitionResponse, error)
+
+	// GetAppDefinitionWithResponse request
+	GetAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetAppDefinitionResponse, error)
+
+	// UpdateAppDefinitionWithBodyWithResponse request with any body
+	UpdateAppDefinitionWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+
+	UpdateAppDefinitionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, params *UpdateAppDefinitionParams, body UpdateAppDefinitionJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAppDefinitionResponse, error)
+
+	// CreateAppBundleWithBodyWithResponse request with any body
+	CreateAppBundleWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
+
+	CreateAppBundleWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, body CreateAppBundleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAppBundleResponse, error)
 
 	// DeleteAppEventSubscriptionWithResponse request
 	DeleteAppEventSubscriptionWithResponse(ctx context.Context, organizationId OrganizationId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*DeleteAppEventSubscriptionResponse, error)
@@ -8676,6 +10752,24 @@ type ClientWithResponsesInterface interface {
 	// PublishAssetWithResponse request
 	PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error)
 
+	// GetBulkActionWithResponse request
+	GetBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, bulkActionId string, reqEditors ...RequestEditorFn) (*GetBulkActionResponse, error)
+
+	// PublishBulkActionWithBodyWithResponse request with any body
+	PublishBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishBulkActionResponse, error)
+
+	PublishBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body PublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*PublishBulkActionResponse, error)
+
+	// UnpublishBulkActionWithBodyWithResponse request with any body
+	UnpublishBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnpublishBulkActionResponse, error)
+
+	UnpublishBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body UnpublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UnpublishBulkActionResponse, error)
+
+	// ValidateBulkActionWithBodyWithResponse request with any body
+	ValidateBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateBulkActionResponse, error)
+
+	ValidateBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body ValidateBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateBulkActionResponse, error)
+
 	// GetAllContentTypesWithResponse request
 	GetAllContentTypesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllContentTypesParams, reqEditors ...RequestEditorFn) (*GetAllContentTypesResponse, error)
 
@@ -8723,6 +10817,11 @@ type ClientWithResponsesInterface interface {
 	// GetEntryWithResponse request
 	GetEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, reqEditors ...RequestEditorFn) (*GetEntryResponse, error)
 
+	// PatchEntryWithBodyWithResponse request with any body
+	PatchEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEntryResponse, error)
+
+	PatchEntryWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, body PatchEntryApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEntryResponse, error)
+
 	// UpdateEntryWithBodyWithResponse request with any body
 	UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error)
 
@@ -8737,8 +10836,10 @@ type ClientWithResponsesInterface interface {
 	// UnpublishEntryWithResponse request
 	UnpublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UnpublishEntryParams, reqEditors ...RequestEditorFn) (*UnpublishEntryResponse, error)
 
-	// PublishEntryWithResponse request
-	PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error)
+	// PublishEntryWithBodyWithResponse request with any body
+	PublishEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error)
+
+	PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, body PublishEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error)
 
 	// GetAllLocalesWithResponse request
 	GetAllLocalesWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllLocalesParams, reqEditors ...RequestEditorFn) (*GetAllLocalesResponse, error)
@@ -8759,75 +10860,460 @@ type ClientWithResponsesInterface interface {
 
 	UpdateLocaleWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, localeId LocaleId, params *UpdateLocaleParams, body UpdateLocaleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateLocaleResponse, error)
 
-	// GetAllPreviewApiKeysWithResponse request
-	GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error)
+	// CreateReleaseWithBodyWithResponse request with any body
+	CreateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error)
+
+	CreateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error)
+
+	// DeleteReleaseWithResponse request
+	DeleteReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*DeleteReleaseResponse, error)
+
+	// GetReleaseWithResponse request
+	GetReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*GetReleaseResponse, error)
+
+	// UpdateReleaseWithBodyWithResponse request with any body
+	UpdateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error)
+
+	UpdateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error)
+
+	// GetReleaseActionWithResponse request
+	GetReleaseActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId string, reqEditors ...RequestEditorFn) (*GetReleaseActionResponse, error)
+
+	// PublishReleaseWithResponse request
+	PublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*PublishReleaseResponse, error)
+
+	// GetAllTagsWithResponse request
+	GetAllTagsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllTagsParams, reqEditors ...RequestEditorFn) (*GetAllTagsResponse, error)
+
+	// DeleteTagWithResponse request
+	DeleteTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error)
+
+	// GetTagWithResponse request
+	GetTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error)
+
+	// UpdateTagWithBodyWithResponse request with any body
+	UpdateTagWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	UpdateTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error)
+
+	// GetAllPreviewApiKeysWithResponse request
+	GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error)
+
+	// GetPreviewApiKeyWithResponse request
+	GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error)
+
+	// CreatePreviewEnvironmentWithBodyWithResponse request with any body
+	CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+
+	CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+
+	// DeletePreviewEnvironmentWithResponse request
+	DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error)
+
+	// GetPreviewEnvironmentWithResponse request
+	GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error)
+
+	// UpdatePreviewEnvironmentWithBodyWithResponse request with any body
+	UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+
+	UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+
+	// GetAllRolesWithResponse request
+	GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error)
+
+	// CreateRoleWithBodyWithResponse request with any body
+	CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+
+	// DeleteRoleWithResponse request
+	DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error)
+
+	// GetRoleWithResponse request
+	GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error)
+
+	// UpdateRoleWithBodyWithResponse request with any body
+	UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+
+	// GetAllWebhooksWithResponse request
+	GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// GetWebhookWithResponse request
+	GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+
+	// UpdateWebhookWithBodyWithResponse request with any body
+	UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+
+	// DeleteWebhookSigningSecretWithResponse request
+	DeleteWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*DeleteWebhookSigningSecretResponse, error)
+
+	// GetWebhookSigningSecretWithResponse request
+	GetWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetWebhookSigningSecretResponse, error)
+
+	// UpdateWebhookSigningSecretWithBodyWithResponse request with any body
+	UpdateWebhookSigningSecretWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookSigningSecretResponse, error)
+
+	UpdateWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, body UpdateWebhookSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookSigningSecretResponse, error)
+
+	// GetWebhookCallsWithResponse request
+	GetWebhookCallsWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookCallsResponse, error)
+
+	// GetWebhookHealthWithResponse request
+	GetWebhookHealthWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookHealthResponse, error)
+}
+
+type GetAllAppDefinitionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinitionCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAllAppDefinitionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllAppDefinitionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAppDefinitionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppDefinition
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAppDefinitionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppDefinitionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAppBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Sys SystemPropertiesBase `json:"sys"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAppBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAppBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppEventSubscription
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetPreviewApiKeyWithResponse request
-	GetPreviewApiKeyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewApiKeyResponse, error)
+type UpdateAppEventSubscriptionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AppEventSubscription
+	JSON201      *AppEventSubscription
+}
 
-	// CreatePreviewEnvironmentWithBodyWithResponse request with any body
-	CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateAppEventSubscriptionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAppEventSubscriptionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// DeletePreviewEnvironmentWithResponse request
-	DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error)
+type UploadAppResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Sys SystemPropertiesBase `json:"sys"`
+	}
+}
 
-	// GetPreviewEnvironmentWithResponse request
-	GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error)
+// Status returns HTTPResponse.Status
+func (r UploadAppResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// UpdatePreviewEnvironmentWithBodyWithResponse request with any body
-	UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAppResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error)
+type GetAllSpacesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SpaceCollection
+}
 
-	// GetAllRolesWithResponse request
-	GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetAllSpacesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// CreateRoleWithBodyWithResponse request with any body
-	CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAllSpacesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error)
+type CreateSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Space
+}
 
-	// DeleteRoleWithResponse request
-	DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error)
+// Status returns HTTPResponse.Status
+func (r CreateSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetRoleWithResponse request
-	GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// UpdateRoleWithBodyWithResponse request with any body
-	UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+type DeleteSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
 
-	UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error)
+// Status returns HTTPResponse.Status
+func (r DeleteSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// GetAllWebhooksWithResponse request
-	GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// CreateWebhookWithBodyWithResponse request with any body
-	CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+type GetSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Space
+}
 
-	CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+// Status returns HTTPResponse.Status
+func (r GetSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	// DeleteWebhookWithResponse request
-	DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	// GetWebhookWithResponse request
-	GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error)
+type UpdateSpaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Space
+}
 
-	// UpdateWebhookWithBodyWithResponse request with any body
-	UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+// Status returns HTTPResponse.Status
+func (r UpdateSpaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error)
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSpaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type GetAllAppDefinitionsResponse struct {
+type GetAllApiKeysResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinitionCollection
+	JSON200      *ApiKeyCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAppDefinitionsResponse) Status() string {
+func (r GetAllApiKeysResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8835,21 +11321,21 @@ func (r GetAllAppDefinitionsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAppDefinitionsResponse) StatusCode() int {
+func (r GetAllApiKeysResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAppDefinitionResponse struct {
+type CreateApiKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *AppDefinition
+	JSON201      *ApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAppDefinitionResponse) Status() string {
+func (r CreateApiKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8857,20 +11343,20 @@ func (r CreateAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppDefinitionResponse) StatusCode() int {
+func (r CreateApiKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppDefinitionResponse struct {
+type DeleteApiKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppDefinitionResponse) Status() string {
+func (r DeleteApiKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8878,21 +11364,21 @@ func (r DeleteAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppDefinitionResponse) StatusCode() int {
+func (r DeleteApiKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppDefinitionResponse struct {
+type GetApiKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinition
+	JSON200      *ApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppDefinitionResponse) Status() string {
+func (r GetApiKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8900,21 +11386,21 @@ func (r GetAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppDefinitionResponse) StatusCode() int {
+func (r GetApiKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppDefinitionResponse struct {
+type UpdateApiKeyResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppDefinition
+	JSON200      *ApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppDefinitionResponse) Status() string {
+func (r UpdateApiKeyResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8922,23 +11408,21 @@ func (r UpdateAppDefinitionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppDefinitionResponse) StatusCode() int {
+func (r UpdateApiKeyResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAppBundleResponse struct {
+type GetAllEnvironmentsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *struct {
-		Sys SystemPropertiesBase `json:"sys"`
-	}
+	JSON200      *EnvironmentCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAppBundleResponse) Status() string {
+func (r GetAllEnvironmentsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8946,20 +11430,21 @@ func (r CreateAppBundleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAppBundleResponse) StatusCode() int {
+func (r GetAllEnvironmentsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppEventSubscriptionResponse struct {
+type CreateEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *Environment
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppEventSubscriptionResponse) Status() string {
+func (r CreateEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8967,21 +11452,20 @@ func (r DeleteAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppEventSubscriptionResponse) StatusCode() int {
+func (r CreateEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppEventSubscriptionResponse struct {
+type DeleteEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppEventSubscription
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppEventSubscriptionResponse) Status() string {
+func (r DeleteEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -8989,22 +11473,21 @@ func (r GetAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppEventSubscriptionResponse) StatusCode() int {
+func (r DeleteEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAppEventSubscriptionResponse struct {
+type GetEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppEventSubscription
-	JSON201      *AppEventSubscription
+	JSON200      *Environment
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAppEventSubscriptionResponse) Status() string {
+func (r GetEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9012,23 +11495,21 @@ func (r UpdateAppEventSubscriptionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAppEventSubscriptionResponse) StatusCode() int {
+func (r GetEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UploadAppResponse struct {
+type UpdateEnvironmentResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *struct {
-		Sys SystemPropertiesBase `json:"sys"`
-	}
+	JSON200      *Environment
 }
 
 // Status returns HTTPResponse.Status
-func (r UploadAppResponse) Status() string {
+func (r UpdateEnvironmentResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9036,21 +11517,21 @@ func (r UploadAppResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UploadAppResponse) StatusCode() int {
+func (r UpdateEnvironmentResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllSpacesResponse struct {
+type GetAllAppInstallationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *SpaceCollection
+	JSON200      *AppInstallationCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllSpacesResponse) Status() string {
+func (r GetAllAppInstallationsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9058,21 +11539,20 @@ func (r GetAllSpacesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllSpacesResponse) StatusCode() int {
+func (r GetAllAppInstallationsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateSpaceResponse struct {
+type DeleteAppInstallationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Space
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateSpaceResponse) Status() string {
+func (r DeleteAppInstallationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9080,20 +11560,21 @@ func (r CreateSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateSpaceResponse) StatusCode() int {
+func (r DeleteAppInstallationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteSpaceResponse struct {
+type GetAppInstallationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *AppInstallation
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteSpaceResponse) Status() string {
+func (r GetAppInstallationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9101,21 +11582,22 @@ func (r DeleteSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteSpaceResponse) StatusCode() int {
+func (r GetAppInstallationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetSpaceResponse struct {
+type UpsertAppInstallationResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Space
+	JSON200      *AppInstallation
+	JSON201      *AppInstallation
 }
 
 // Status returns HTTPResponse.Status
-func (r GetSpaceResponse) Status() string {
+func (r UpsertAppInstallationResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9123,21 +11605,21 @@ func (r GetSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetSpaceResponse) StatusCode() int {
+func (r UpsertAppInstallationResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateSpaceResponse struct {
+type GetAllAssetsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Space
+	JSON200      *AssetCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateSpaceResponse) Status() string {
+func (r GetAllAssetsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9145,21 +11627,21 @@ func (r UpdateSpaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateSpaceResponse) StatusCode() int {
+func (r GetAllAssetsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllApiKeysResponse struct {
+type CreateAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKeyCollection
+	JSON201      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllApiKeysResponse) Status() string {
+func (r CreateAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9167,21 +11649,20 @@ func (r GetAllApiKeysResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllApiKeysResponse) StatusCode() int {
+func (r CreateAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateApiKeyResponse struct {
+type DeleteAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ApiKey
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateApiKeyResponse) Status() string {
+func (r DeleteAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9189,20 +11670,21 @@ func (r CreateApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateApiKeyResponse) StatusCode() int {
+func (r DeleteAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteApiKeyResponse struct {
+type GetAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteApiKeyResponse) Status() string {
+func (r GetAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9210,21 +11692,22 @@ func (r DeleteApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteApiKeyResponse) StatusCode() int {
+func (r GetAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetApiKeyResponse struct {
+type UpdateAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKey
+	JSON200      *Asset
+	JSON201      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetApiKeyResponse) Status() string {
+func (r UpdateAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9232,21 +11715,21 @@ func (r GetApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetApiKeyResponse) StatusCode() int {
+func (r UpdateAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateApiKeyResponse struct {
+type UnarchiveAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ApiKey
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateApiKeyResponse) Status() string {
+func (r UnarchiveAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9254,21 +11737,21 @@ func (r UpdateApiKeyResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateApiKeyResponse) StatusCode() int {
+func (r UnarchiveAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllEnvironmentsResponse struct {
+type ArchiveAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EnvironmentCollection
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllEnvironmentsResponse) Status() string {
+func (r ArchiveAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9276,21 +11759,20 @@ func (r GetAllEnvironmentsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllEnvironmentsResponse) StatusCode() int {
+func (r ArchiveAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateEnvironmentResponse struct {
+type ProcessAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Environment
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateEnvironmentResponse) Status() string {
+func (r ProcessAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9298,20 +11780,21 @@ func (r CreateEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateEnvironmentResponse) StatusCode() int {
+func (r ProcessAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteEnvironmentResponse struct {
+type UnpublishAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteEnvironmentResponse) Status() string {
+func (r UnpublishAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9319,21 +11802,21 @@ func (r DeleteEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEnvironmentResponse) StatusCode() int {
+func (r UnpublishAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEnvironmentResponse struct {
+type PublishAssetResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Environment
+	JSON200      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEnvironmentResponse) Status() string {
+func (r PublishAssetResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9341,21 +11824,21 @@ func (r GetEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEnvironmentResponse) StatusCode() int {
+func (r PublishAssetResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEnvironmentResponse struct {
+type GetBulkActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Environment
+	JSON200      *BulkAction
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEnvironmentResponse) Status() string {
+func (r GetBulkActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9363,21 +11846,21 @@ func (r UpdateEnvironmentResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEnvironmentResponse) StatusCode() int {
+func (r GetBulkActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllAppInstallationsResponse struct {
+type PublishBulkActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallationCollection
+	JSON201      *BulkAction
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAppInstallationsResponse) Status() string {
+func (r PublishBulkActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9385,20 +11868,21 @@ func (r GetAllAppInstallationsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAppInstallationsResponse) StatusCode() int {
+func (r PublishBulkActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAppInstallationResponse struct {
+type UnpublishBulkActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON201      *BulkAction
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAppInstallationResponse) Status() string {
+func (r UnpublishBulkActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9406,21 +11890,21 @@ func (r DeleteAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAppInstallationResponse) StatusCode() int {
+func (r UnpublishBulkActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAppInstallationResponse struct {
+type ValidateBulkActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallation
+	JSON201      *BulkAction
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAppInstallationResponse) Status() string {
+func (r ValidateBulkActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9428,22 +11912,21 @@ func (r GetAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAppInstallationResponse) StatusCode() int {
+func (r ValidateBulkActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpsertAppInstallationResponse struct {
+type GetAllContentTypesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AppInstallation
-	JSON201      *AppInstallation
+	JSON200      *ContentTypeCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r UpsertAppInstallationResponse) Status() string {
+func (r GetAllContentTypesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9451,21 +11934,21 @@ func (r UpsertAppInstallationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpsertAppInstallationResponse) StatusCode() int {
+func (r GetAllContentTypesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllAssetsResponse struct {
+type CreateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AssetCollection
+	JSON201      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllAssetsResponse) Status() string {
+func (r CreateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9473,21 +11956,20 @@ func (r GetAllAssetsResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllAssetsResponse) StatusCode() int {
+func (r CreateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateAssetResponse struct {
+type DeleteContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Asset
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateAssetResponse) Status() string {
+func (r DeleteContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9495,20 +11977,21 @@ func (r CreateAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateAssetResponse) StatusCode() int {
+func (r DeleteContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteAssetResponse struct {
+type GetContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteAssetResponse) Status() string {
+func (r GetContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9516,21 +11999,22 @@ func (r DeleteAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteAssetResponse) StatusCode() int {
+func (r GetContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAssetResponse struct {
+type UpdateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON200      *ContentType
+	JSON201      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAssetResponse) Status() string {
+func (r UpdateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9538,22 +12022,21 @@ func (r GetAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAssetResponse) StatusCode() int {
+func (r UpdateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateAssetResponse struct {
+type GetEditorInterfaceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
-	JSON201      *Asset
+	JSON200      *EditorInterface
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateAssetResponse) Status() string {
+func (r GetEditorInterfaceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9561,21 +12044,21 @@ func (r UpdateAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateAssetResponse) StatusCode() int {
+func (r GetEditorInterfaceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnarchiveAssetResponse struct {
+type UpdateEditorInterfaceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON200      *EditorInterface
 }
 
 // Status returns HTTPResponse.Status
-func (r UnarchiveAssetResponse) Status() string {
+func (r UpdateEditorInterfaceResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9583,21 +12066,21 @@ func (r UnarchiveAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnarchiveAssetResponse) StatusCode() int {
+func (r UpdateEditorInterfaceResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveAssetResponse struct {
+type DeactivateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r ArchiveAssetResponse) Status() string {
+func (r DeactivateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9605,20 +12088,21 @@ func (r ArchiveAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveAssetResponse) StatusCode() int {
+func (r DeactivateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ProcessAssetResponse struct {
+type ActivateContentTypeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *ContentType
 }
 
 // Status returns HTTPResponse.Status
-func (r ProcessAssetResponse) Status() string {
+func (r ActivateContentTypeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9626,21 +12110,21 @@ func (r ProcessAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ProcessAssetResponse) StatusCode() int {
+func (r ActivateContentTypeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnpublishAssetResponse struct {
+type GetAllEntriesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON200      *EntryCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r UnpublishAssetResponse) Status() string {
+func (r GetAllEntriesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9648,21 +12132,21 @@ func (r UnpublishAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnpublishAssetResponse) StatusCode() int {
+func (r GetAllEntriesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PublishAssetResponse struct {
+type CreateEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Asset
+	JSON201      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r PublishAssetResponse) Status() string {
+func (r CreateEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9670,21 +12154,20 @@ func (r PublishAssetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PublishAssetResponse) StatusCode() int {
+func (r CreateEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllContentTypesResponse struct {
+type DeleteEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentTypeCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllContentTypesResponse) Status() string {
+func (r DeleteEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9692,21 +12175,21 @@ func (r GetAllContentTypesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllContentTypesResponse) StatusCode() int {
+func (r DeleteEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateContentTypeResponse struct {
+type GetEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *ContentType
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateContentTypeResponse) Status() string {
+func (r GetEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9714,20 +12197,21 @@ func (r CreateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateContentTypeResponse) StatusCode() int {
+func (r GetEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteContentTypeResponse struct {
+type PatchEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteContentTypeResponse) Status() string {
+func (r PatchEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9735,21 +12219,22 @@ func (r DeleteContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteContentTypeResponse) StatusCode() int {
+func (r PatchEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetContentTypeResponse struct {
+type UpdateEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *Entry
+	JSON201      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetContentTypeResponse) Status() string {
+func (r UpdateEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9757,22 +12242,21 @@ func (r GetContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetContentTypeResponse) StatusCode() int {
+func (r UpdateEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateContentTypeResponse struct {
+type UnarchiveEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
-	JSON201      *ContentType
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateContentTypeResponse) Status() string {
+func (r UnarchiveEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9780,21 +12264,21 @@ func (r UpdateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateContentTypeResponse) StatusCode() int {
+func (r UnarchiveEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEditorInterfaceResponse struct {
+type ArchiveEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EditorInterface
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEditorInterfaceResponse) Status() string {
+func (r ArchiveEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9802,21 +12286,21 @@ func (r GetEditorInterfaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEditorInterfaceResponse) StatusCode() int {
+func (r ArchiveEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEditorInterfaceResponse struct {
+type UnpublishEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EditorInterface
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEditorInterfaceResponse) Status() string {
+func (r UnpublishEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9824,21 +12308,21 @@ func (r UpdateEditorInterfaceResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEditorInterfaceResponse) StatusCode() int {
+func (r UnpublishEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeactivateContentTypeResponse struct {
+type PublishEntryResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r DeactivateContentTypeResponse) Status() string {
+func (r PublishEntryResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9846,21 +12330,21 @@ func (r DeactivateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeactivateContentTypeResponse) StatusCode() int {
+func (r PublishEntryResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ActivateContentTypeResponse struct {
+type GetAllLocalesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ContentType
+	JSON200      *LocaleCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r ActivateContentTypeResponse) Status() string {
+func (r GetAllLocalesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9868,21 +12352,21 @@ func (r ActivateContentTypeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ActivateContentTypeResponse) StatusCode() int {
+func (r GetAllLocalesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllEntriesResponse struct {
+type CreateLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *EntryCollection
+	JSON201      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllEntriesResponse) Status() string {
+func (r CreateLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9890,21 +12374,20 @@ func (r GetAllEntriesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllEntriesResponse) StatusCode() int {
+func (r CreateLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateEntryResponse struct {
+type DeleteLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateEntryResponse) Status() string {
+func (r DeleteLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9912,20 +12395,21 @@ func (r CreateEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateEntryResponse) StatusCode() int {
+func (r DeleteLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteEntryResponse struct {
+type GetLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON200      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteEntryResponse) Status() string {
+func (r GetLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9933,21 +12417,21 @@ func (r DeleteEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteEntryResponse) StatusCode() int {
+func (r GetLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetEntryResponse struct {
+type UpdateLocaleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Locale
 }
 
 // Status returns HTTPResponse.Status
-func (r GetEntryResponse) Status() string {
+func (r UpdateLocaleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9955,22 +12439,21 @@ func (r GetEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetEntryResponse) StatusCode() int {
+func (r UpdateLocaleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateEntryResponse struct {
+type CreateReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
-	JSON201      *Entry
+	JSON201      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateEntryResponse) Status() string {
+func (r CreateReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -9978,21 +12461,20 @@ func (r UpdateEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateEntryResponse) StatusCode() int {
+func (r CreateReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnarchiveEntryResponse struct {
+type DeleteReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
 }
 
 // Status returns HTTPResponse.Status
-func (r UnarchiveEntryResponse) Status() string {
+func (r DeleteReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10000,21 +12482,21 @@ func (r UnarchiveEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnarchiveEntryResponse) StatusCode() int {
+func (r DeleteReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type ArchiveEntryResponse struct {
+type GetReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r ArchiveEntryResponse) Status() string {
+func (r GetReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10022,21 +12504,21 @@ func (r ArchiveEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r ArchiveEntryResponse) StatusCode() int {
+func (r GetReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UnpublishEntryResponse struct {
+type UpdateReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *Release
 }
 
 // Status returns HTTPResponse.Status
-func (r UnpublishEntryResponse) Status() string {
+func (r UpdateReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10044,21 +12526,21 @@ func (r UnpublishEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UnpublishEntryResponse) StatusCode() int {
+func (r UpdateReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PublishEntryResponse struct {
+type GetReleaseActionResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Entry
+	JSON200      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r PublishEntryResponse) Status() string {
+func (r GetReleaseActionResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10066,21 +12548,21 @@ func (r PublishEntryResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PublishEntryResponse) StatusCode() int {
+func (r GetReleaseActionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetAllLocalesResponse struct {
+type PublishReleaseResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *LocaleCollection
+	JSON202      *ReleaseAction
 }
 
 // Status returns HTTPResponse.Status
-func (r GetAllLocalesResponse) Status() string {
+func (r PublishReleaseResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10088,21 +12570,21 @@ func (r GetAllLocalesResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetAllLocalesResponse) StatusCode() int {
+func (r PublishReleaseResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type CreateLocaleResponse struct {
+type GetAllTagsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON201      *Locale
+	JSON200      *TagCollection
 }
 
 // Status returns HTTPResponse.Status
-func (r CreateLocaleResponse) Status() string {
+func (r GetAllTagsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10110,20 +12592,20 @@ func (r CreateLocaleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r CreateLocaleResponse) StatusCode() int {
+func (r GetAllTagsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type DeleteLocaleResponse struct {
+type DeleteTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r DeleteLocaleResponse) Status() string {
+func (r DeleteTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10131,21 +12613,21 @@ func (r DeleteLocaleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteLocaleResponse) StatusCode() int {
+func (r DeleteTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetLocaleResponse struct {
+type GetTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Locale
+	JSON200      *Tag
 }
 
 // Status returns HTTPResponse.Status
-func (r GetLocaleResponse) Status() string {
+func (r GetTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10153,21 +12635,22 @@ func (r GetLocaleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetLocaleResponse) StatusCode() int {
+func (r GetTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type UpdateLocaleResponse struct {
+type UpdateTagResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *Locale
+	JSON200      *Tag
+	JSON201      *Tag
 }
 
 // Status returns HTTPResponse.Status
-func (r UpdateLocaleResponse) Status() string {
+func (r UpdateTagResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -10175,7 +12658,7 @@ func (r UpdateLocaleResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r UpdateLocaleResponse) StatusCode() int {
+func (r UpdateTagResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -10531,6 +13014,115 @@ func (r UpdateWebhookResponse) StatusCode() int {
 	return 0
 }
 
+type DeleteWebhookSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSigningSecret
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateWebhookSigningSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookSigningSecret
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateWebhookSigningSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateWebhookSigningSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookCallsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookCallCollection
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookCallsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookCallsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookHealth
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetAllAppDefinitionsWithResponse request returning *GetAllAppDefinitionsResponse
 func (c *ClientWithResponses) GetAllAppDefinitionsWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAllAppDefinitionsParams, reqEditors ...RequestEditorFn) (*GetAllAppDefinitionsResponse, error) {
 	rsp, err := c.GetAllAppDefinitions(ctx, organizationId, params, reqEditors...)
@@ -10924,66 +13516,126 @@ func (c *ClientWithResponses) GetAssetWithResponse(ctx context.Context, spaceId
 	return ParseGetAssetResponse(rsp)
 }
 
-// UpdateAssetWithBodyWithResponse request with arbitrary body returning *UpdateAssetResponse
-func (c *ClientWithResponses) UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
-	rsp, err := c.UpdateAssetWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+// UpdateAssetWithBodyWithResponse request with arbitrary body returning *UpdateAssetResponse
+func (c *ClientWithResponses) UpdateAssetWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
+	rsp, err := c.UpdateAssetWithBody(ctx, spaceId, environmentId, resourceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAssetResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
+	rsp, err := c.UpdateAsset(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAssetResponse(rsp)
+}
+
+// UnarchiveAssetWithResponse request returning *UnarchiveAssetResponse
+func (c *ClientWithResponses) UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error) {
+	rsp, err := c.UnarchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnarchiveAssetResponse(rsp)
+}
+
+// ArchiveAssetWithResponse request returning *ArchiveAssetResponse
+func (c *ClientWithResponses) ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error) {
+	rsp, err := c.ArchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveAssetResponse(rsp)
+}
+
+// ProcessAssetWithResponse request returning *ProcessAssetResponse
+func (c *ClientWithResponses) ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error) {
+	rsp, err := c.ProcessAsset(ctx, spaceId, environmentId, resourceId, localeCode, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProcessAssetResponse(rsp)
+}
+
+// UnpublishAssetWithResponse request returning *UnpublishAssetResponse
+func (c *ClientWithResponses) UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error) {
+	rsp, err := c.UnpublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnpublishAssetResponse(rsp)
+}
+
+// PublishAssetWithResponse request returning *PublishAssetResponse
+func (c *ClientWithResponses) PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error) {
+	rsp, err := c.PublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishAssetResponse(rsp)
+}
+
+// GetBulkActionWithResponse request returning *GetBulkActionResponse
+func (c *ClientWithResponses) GetBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, bulkActionId string, reqEditors ...RequestEditorFn) (*GetBulkActionResponse, error) {
+	rsp, err := c.GetBulkAction(ctx, spaceId, environmentId, bulkActionId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAssetResponse(rsp)
+	return ParseGetBulkActionResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdateAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UpdateAssetParams, body UpdateAssetJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAssetResponse, error) {
-	rsp, err := c.UpdateAsset(ctx, spaceId, environmentId, resourceId, params, body, reqEditors...)
+// PublishBulkActionWithBodyWithResponse request with arbitrary body returning *PublishBulkActionResponse
+func (c *ClientWithResponses) PublishBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishBulkActionResponse, error) {
+	rsp, err := c.PublishBulkActionWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateAssetResponse(rsp)
+	return ParsePublishBulkActionResponse(rsp)
 }
 
-// UnarchiveAssetWithResponse request returning *UnarchiveAssetResponse
-func (c *ClientWithResponses) UnarchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnarchiveAssetParams, reqEditors ...RequestEditorFn) (*UnarchiveAssetResponse, error) {
-	rsp, err := c.UnarchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) PublishBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body PublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*PublishBulkActionResponse, error) {
+	rsp, err := c.PublishBulkAction(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnarchiveAssetResponse(rsp)
+	return ParsePublishBulkActionResponse(rsp)
 }
 
-// ArchiveAssetWithResponse request returning *ArchiveAssetResponse
-func (c *ClientWithResponses) ArchiveAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *ArchiveAssetParams, reqEditors ...RequestEditorFn) (*ArchiveAssetResponse, error) {
-	rsp, err := c.ArchiveAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+// UnpublishBulkActionWithBodyWithResponse request with arbitrary body returning *UnpublishBulkActionResponse
+func (c *ClientWithResponses) UnpublishBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UnpublishBulkActionResponse, error) {
+	rsp, err := c.UnpublishBulkActionWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseArchiveAssetResponse(rsp)
+	return ParseUnpublishBulkActionResponse(rsp)
 }
 
-// ProcessAssetWithResponse request returning *ProcessAssetResponse
-func (c *ClientWithResponses) ProcessAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, localeCode LocaleCode, reqEditors ...RequestEditorFn) (*ProcessAssetResponse, error) {
-	rsp, err := c.ProcessAsset(ctx, spaceId, environmentId, resourceId, localeCode, reqEditors...)
+func (c *ClientWithResponses) UnpublishBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body UnpublishBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*UnpublishBulkActionResponse, error) {
+	rsp, err := c.UnpublishBulkAction(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseProcessAssetResponse(rsp)
+	return ParseUnpublishBulkActionResponse(rsp)
 }
 
-// UnpublishAssetWithResponse request returning *UnpublishAssetResponse
-func (c *ClientWithResponses) UnpublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *UnpublishAssetParams, reqEditors ...RequestEditorFn) (*UnpublishAssetResponse, error) {
-	rsp, err := c.UnpublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+// ValidateBulkActionWithBodyWithResponse request with arbitrary body returning *ValidateBulkActionResponse
+func (c *ClientWithResponses) ValidateBulkActionWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ValidateBulkActionResponse, error) {
+	rsp, err := c.ValidateBulkActionWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUnpublishAssetResponse(rsp)
+	return ParseValidateBulkActionResponse(rsp)
 }
 
-// PublishAssetWithResponse request returning *PublishAssetResponse
-func (c *ClientWithResponses) PublishAssetWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, resourceId ResourceId, params *PublishAssetParams, reqEditors ...RequestEditorFn) (*PublishAssetResponse, error) {
-	rsp, err := c.PublishAsset(ctx, spaceId, environmentId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) ValidateBulkActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body ValidateBulkActionJSONRequestBody, reqEditors ...RequestEditorFn) (*ValidateBulkActionResponse, error) {
+	rsp, err := c.ValidateBulkAction(ctx, spaceId, environmentId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParsePublishAssetResponse(rsp)
+	return ParseValidateBulkActionResponse(rsp)
 }
 
 // GetAllContentTypesWithResponse request returning *GetAllContentTypesResponse
@@ -11135,6 +13787,23 @@ func (c *ClientWithResponses) GetEntryWithResponse(ctx context.Context, spaceId
 	return ParseGetEntryResponse(rsp)
 }
 
+// PatchEntryWithBodyWithResponse request with arbitrary body returning *PatchEntryResponse
+func (c *ClientWithResponses) PatchEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PatchEntryResponse, error) {
+	rsp, err := c.PatchEntryWithBody(ctx, spaceId, environmentId, entryId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PatchEntryWithApplicationJSONPatchPlusJSONBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PatchEntryParams, body PatchEntryApplicationJSONPatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*PatchEntryResponse, error) {
+	rsp, err := c.PatchEntryWithApplicationJSONPatchPlusJSONBody(ctx, spaceId, environmentId, entryId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePatchEntryResponse(rsp)
+}
+
 // UpdateEntryWithBodyWithResponse request with arbitrary body returning *UpdateEntryResponse
 func (c *ClientWithResponses) UpdateEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *UpdateEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateEntryResponse, error) {
 	rsp, err := c.UpdateEntryWithBody(ctx, spaceId, environmentId, entryId, params, contentType, body, reqEditors...)
@@ -11179,9 +13848,17 @@ func (c *ClientWithResponses) UnpublishEntryWithResponse(ctx context.Context, sp
 	return ParseUnpublishEntryResponse(rsp)
 }
 
-// PublishEntryWithResponse request returning *PublishEntryResponse
-func (c *ClientWithResponses) PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error) {
-	rsp, err := c.PublishEntry(ctx, spaceId, environmentId, entryId, params, reqEditors...)
+// PublishEntryWithBodyWithResponse request with arbitrary body returning *PublishEntryResponse
+func (c *ClientWithResponses) PublishEntryWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error) {
+	rsp, err := c.PublishEntryWithBody(ctx, spaceId, environmentId, entryId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishEntryResponse(rsp)
+}
+
+func (c *ClientWithResponses) PublishEntryWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, entryId EntryId, params *PublishEntryParams, body PublishEntryJSONRequestBody, reqEditors ...RequestEditorFn) (*PublishEntryResponse, error) {
+	rsp, err := c.PublishEntry(ctx, spaceId, environmentId, entryId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
@@ -11249,6 +13926,120 @@ func (c *ClientWithResponses) UpdateLocaleWithResponse(ctx context.Context, spac
 	return ParseUpdateLocaleResponse(rsp)
 }
 
+// CreateReleaseWithBodyWithResponse request with arbitrary body returning *CreateReleaseResponse
+func (c *ClientWithResponses) CreateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error) {
+	rsp, err := c.CreateReleaseWithBody(ctx, spaceId, environmentId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateReleaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, body CreateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateReleaseResponse, error) {
+	rsp, err := c.CreateRelease(ctx, spaceId, environmentId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateReleaseResponse(rsp)
+}
+
+// DeleteReleaseWithResponse request returning *DeleteReleaseResponse
+func (c *ClientWithResponses) DeleteReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*DeleteReleaseResponse, error) {
+	rsp, err := c.DeleteRelease(ctx, spaceId, environmentId, releaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteReleaseResponse(rsp)
+}
+
+// GetReleaseWithResponse request returning *GetReleaseResponse
+func (c *ClientWithResponses) GetReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, reqEditors ...RequestEditorFn) (*GetReleaseResponse, error) {
+	rsp, err := c.GetRelease(ctx, spaceId, environmentId, releaseId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReleaseResponse(rsp)
+}
+
+// UpdateReleaseWithBodyWithResponse request with arbitrary body returning *UpdateReleaseResponse
+func (c *ClientWithResponses) UpdateReleaseWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error) {
+	rsp, err := c.UpdateReleaseWithBody(ctx, spaceId, environmentId, releaseId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateReleaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *UpdateReleaseParams, body UpdateReleaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateReleaseResponse, error) {
+	rsp, err := c.UpdateRelease(ctx, spaceId, environmentId, releaseId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateReleaseResponse(rsp)
+}
+
+// GetReleaseActionWithResponse request returning *GetReleaseActionResponse
+func (c *ClientWithResponses) GetReleaseActionWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, releaseActionId string, reqEditors ...RequestEditorFn) (*GetReleaseActionResponse, error) {
+	rsp, err := c.GetReleaseAction(ctx, spaceId, environmentId, releaseId, releaseActionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetReleaseActionResponse(rsp)
+}
+
+// PublishReleaseWithResponse request returning *PublishReleaseResponse
+func (c *ClientWithResponses) PublishReleaseWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, releaseId ReleaseId, params *PublishReleaseParams, reqEditors ...RequestEditorFn) (*PublishReleaseResponse, error) {
+	rsp, err := c.PublishRelease(ctx, spaceId, environmentId, releaseId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishReleaseResponse(rsp)
+}
+
+// GetAllTagsWithResponse request returning *GetAllTagsResponse
+func (c *ClientWithResponses) GetAllTagsWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, params *GetAllTagsParams, reqEditors ...RequestEditorFn) (*GetAllTagsResponse, error) {
+	rsp, err := c.GetAllTags(ctx, spaceId, environmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllTagsResponse(rsp)
+}
+
+// DeleteTagWithResponse request returning *DeleteTagResponse
+func (c *ClientWithResponses) DeleteTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *DeleteTagParams, reqEditors ...RequestEditorFn) (*DeleteTagResponse, error) {
+	rsp, err := c.DeleteTag(ctx, spaceId, environmentId, tagId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteTagResponse(rsp)
+}
+
+// GetTagWithResponse request returning *GetTagResponse
+func (c *ClientWithResponses) GetTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, reqEditors ...RequestEditorFn) (*GetTagResponse, error) {
+	rsp, err := c.GetTag(ctx, spaceId, environmentId, tagId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTagResponse(rsp)
+}
+
+// UpdateTagWithBodyWithResponse request with arbitrary body returning *UpdateTagResponse
+func (c *ClientWithResponses) UpdateTagWithBodyWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTagWithBody(ctx, spaceId, environmentId, tagId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateTagWithResponse(ctx context.Context, spaceId SpaceId, environmentId EnvironmentId, tagId TagId, params *UpdateTagParams, body UpdateTagJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateTagResponse, error) {
+	rsp, err := c.UpdateTag(ctx, spaceId, environmentId, tagId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTagResponse(rsp)
+}
+
 // GetAllPreviewApiKeysWithResponse request returning *GetAllPreviewApiKeysResponse
 func (c *ClientWithResponses) GetAllPreviewApiKeysWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllPreviewApiKeysParams, reqEditors ...RequestEditorFn) (*GetAllPreviewApiKeysResponse, error) {
 	rsp, err := c.GetAllPreviewApiKeys(ctx, spaceId, params, reqEditors...)
@@ -11267,196 +14058,500 @@ func (c *ClientWithResponses) GetPreviewApiKeyWithResponse(ctx context.Context,
 	return ParseGetPreviewApiKeyResponse(rsp)
 }
 
-// CreatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *CreatePreviewEnvironmentResponse
-func (c *ClientWithResponses) CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
-	rsp, err := c.CreatePreviewEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// CreatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *CreatePreviewEnvironmentResponse
+func (c *ClientWithResponses) CreatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
+	rsp, err := c.CreatePreviewEnvironmentWithBody(ctx, spaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePreviewEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
+	rsp, err := c.CreatePreviewEnvironment(ctx, spaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreatePreviewEnvironmentResponse(rsp)
+}
+
+// DeletePreviewEnvironmentWithResponse request returning *DeletePreviewEnvironmentResponse
+func (c *ClientWithResponses) DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error) {
+	rsp, err := c.DeletePreviewEnvironment(ctx, spaceId, resourceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeletePreviewEnvironmentResponse(rsp)
+}
+
+// GetPreviewEnvironmentWithResponse request returning *GetPreviewEnvironmentResponse
+func (c *ClientWithResponses) GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error) {
+	rsp, err := c.GetPreviewEnvironment(ctx, spaceId, resourceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPreviewEnvironmentResponse(rsp)
+}
+
+// UpdatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdatePreviewEnvironmentResponse
+func (c *ClientWithResponses) UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
+	rsp, err := c.UpdatePreviewEnvironmentWithBody(ctx, spaceId, resourceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePreviewEnvironmentResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
+	rsp, err := c.UpdatePreviewEnvironment(ctx, spaceId, resourceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdatePreviewEnvironmentResponse(rsp)
+}
+
+// GetAllRolesWithResponse request returning *GetAllRolesResponse
+func (c *ClientWithResponses) GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error) {
+	rsp, err := c.GetAllRoles(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllRolesResponse(rsp)
+}
+
+// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
+func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRoleWithBody(ctx, spaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
+	rsp, err := c.CreateRole(ctx, spaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRoleResponse(rsp)
+}
+
+// DeleteRoleWithResponse request returning *DeleteRoleResponse
+func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
+	rsp, err := c.DeleteRole(ctx, spaceId, roleId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRoleResponse(rsp)
+}
+
+// GetRoleWithResponse request returning *GetRoleResponse
+func (c *ClientWithResponses) GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
+	rsp, err := c.GetRole(ctx, spaceId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRoleResponse(rsp)
+}
+
+// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
+func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRoleWithBody(ctx, spaceId, roleId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
+	rsp, err := c.UpdateRole(ctx, spaceId, roleId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateRoleResponse(rsp)
+}
+
+// GetAllWebhooksWithResponse request returning *GetAllWebhooksResponse
+func (c *ClientWithResponses) GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error) {
+	rsp, err := c.GetAllWebhooks(ctx, spaceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, spaceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, spaceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, spaceId, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// GetWebhookWithResponse request returning *GetWebhookResponse
+func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
+	rsp, err := c.GetWebhook(ctx, spaceId, webhookId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePreviewEnvironmentResponse(rsp)
+	return ParseGetWebhookResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, body CreatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*CreatePreviewEnvironmentResponse, error) {
-	rsp, err := c.CreatePreviewEnvironment(ctx, spaceId, body, reqEditors...)
+// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
+func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhookWithBody(ctx, spaceId, webhookId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreatePreviewEnvironmentResponse(rsp)
+	return ParseUpdateWebhookResponse(rsp)
 }
 
-// DeletePreviewEnvironmentWithResponse request returning *DeletePreviewEnvironmentResponse
-func (c *ClientWithResponses) DeletePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *DeletePreviewEnvironmentParams, reqEditors ...RequestEditorFn) (*DeletePreviewEnvironmentResponse, error) {
-	rsp, err := c.DeletePreviewEnvironment(ctx, spaceId, resourceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
+	rsp, err := c.UpdateWebhook(ctx, spaceId, webhookId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeletePreviewEnvironmentResponse(rsp)
+	return ParseUpdateWebhookResponse(rsp)
 }
 
-// GetPreviewEnvironmentWithResponse request returning *GetPreviewEnvironmentResponse
-func (c *ClientWithResponses) GetPreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, reqEditors ...RequestEditorFn) (*GetPreviewEnvironmentResponse, error) {
-	rsp, err := c.GetPreviewEnvironment(ctx, spaceId, resourceId, reqEditors...)
+// DeleteWebhookSigningSecretWithResponse request returning *DeleteWebhookSigningSecretResponse
+func (c *ClientWithResponses) DeleteWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*DeleteWebhookSigningSecretResponse, error) {
+	rsp, err := c.DeleteWebhookSigningSecret(ctx, spaceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetPreviewEnvironmentResponse(rsp)
+	return ParseDeleteWebhookSigningSecretResponse(rsp)
 }
 
-// UpdatePreviewEnvironmentWithBodyWithResponse request with arbitrary body returning *UpdatePreviewEnvironmentResponse
-func (c *ClientWithResponses) UpdatePreviewEnvironmentWithBodyWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
-	rsp, err := c.UpdatePreviewEnvironmentWithBody(ctx, spaceId, resourceId, params, contentType, body, reqEditors...)
+// GetWebhookSigningSecretWithResponse request returning *GetWebhookSigningSecretResponse
+func (c *ClientWithResponses) GetWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, reqEditors ...RequestEditorFn) (*GetWebhookSigningSecretResponse, error) {
+	rsp, err := c.GetWebhookSigningSecret(ctx, spaceId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePreviewEnvironmentResponse(rsp)
+	return ParseGetWebhookSigningSecretResponse(rsp)
 }
 
-func (c *ClientWithResponses) UpdatePreviewEnvironmentWithResponse(ctx context.Context, spaceId SpaceId, resourceId ResourceId, params *UpdatePreviewEnvironmentParams, body UpdatePreviewEnvironmentJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdatePreviewEnvironmentResponse, error) {
-	rsp, err := c.UpdatePreviewEnvironment(ctx, spaceId, resourceId, params, body, reqEditors...)
+// UpdateWebhookSigningSecretWithBodyWithResponse request with arbitrary body returning *UpdateWebhookSigningSecretResponse
+func (c *ClientWithResponses) UpdateWebhookSigningSecretWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookSigningSecretResponse, error) {
+	rsp, err := c.UpdateWebhookSigningSecretWithBody(ctx, spaceId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdatePreviewEnvironmentResponse(rsp)
+	return ParseUpdateWebhookSigningSecretResponse(rsp)
 }
 
-// GetAllRolesWithResponse request returning *GetAllRolesResponse
-func (c *ClientWithResponses) GetAllRolesWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllRolesParams, reqEditors ...RequestEditorFn) (*GetAllRolesResponse, error) {
-	rsp, err := c.GetAllRoles(ctx, spaceId, params, reqEditors...)
+func (c *ClientWithResponses) UpdateWebhookSigningSecretWithResponse(ctx context.Context, spaceId SpaceId, body UpdateWebhookSigningSecretJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookSigningSecretResponse, error) {
+	rsp, err := c.UpdateWebhookSigningSecret(ctx, spaceId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllRolesResponse(rsp)
+	return ParseUpdateWebhookSigningSecretResponse(rsp)
 }
 
-// CreateRoleWithBodyWithResponse request with arbitrary body returning *CreateRoleResponse
-func (c *ClientWithResponses) CreateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRoleWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// GetWebhookCallsWithResponse request returning *GetWebhookCallsResponse
+func (c *ClientWithResponses) GetWebhookCallsWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookCallsResponse, error) {
+	rsp, err := c.GetWebhookCalls(ctx, spaceId, webhookId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateRoleResponse(rsp)
+	return ParseGetWebhookCallsResponse(rsp)
 }
 
-func (c *ClientWithResponses) CreateRoleWithResponse(ctx context.Context, spaceId SpaceId, body CreateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRoleResponse, error) {
-	rsp, err := c.CreateRole(ctx, spaceId, body, reqEditors...)
+// GetWebhookHealthWithResponse request returning *GetWebhookHealthResponse
+func (c *ClientWithResponses) GetWebhookHealthWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookHealthResponse, error) {
+	rsp, err := c.GetWebhookHealth(ctx, spaceId, webhookId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateRoleResponse(rsp)
+	return ParseGetWebhookHealthResponse(rsp)
 }
 
-// DeleteRoleWithResponse request returning *DeleteRoleResponse
-func (c *ClientWithResponses) DeleteRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *DeleteRoleParams, reqEditors ...RequestEditorFn) (*DeleteRoleResponse, error) {
-	rsp, err := c.DeleteRole(ctx, spaceId, roleId, params, reqEditors...)
+// ParseGetAllAppDefinitionsResponse parses an HTTP response from a GetAllAppDefinitionsWithResponse call
+func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinitionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteRoleResponse(rsp)
+
+	response := &GetAllAppDefinitionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinitionCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetRoleWithResponse request returning *GetRoleResponse
-func (c *ClientWithResponses) GetRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, reqEditors ...RequestEditorFn) (*GetRoleResponse, error) {
-	rsp, err := c.GetRole(ctx, spaceId, roleId, reqEditors...)
+// ParseCreateAppDefinitionResponse parses an HTTP response from a CreateAppDefinitionWithResponse call
+func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetRoleResponse(rsp)
+
+	response := &CreateAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdateRoleWithBodyWithResponse request with arbitrary body returning *UpdateRoleResponse
-func (c *ClientWithResponses) UpdateRoleWithBodyWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
-	rsp, err := c.UpdateRoleWithBody(ctx, spaceId, roleId, params, contentType, body, reqEditors...)
+// ParseDeleteAppDefinitionResponse parses an HTTP response from a DeleteAppDefinitionWithResponse call
+func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateRoleResponse(rsp)
+
+	response := &DeleteAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) UpdateRoleWithResponse(ctx context.Context, spaceId SpaceId, roleId RoleId, params *UpdateRoleParams, body UpdateRoleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateRoleResponse, error) {
-	rsp, err := c.UpdateRole(ctx, spaceId, roleId, params, body, reqEditors...)
+// ParseGetAppDefinitionResponse parses an HTTP response from a GetAppDefinitionWithResponse call
+func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateRoleResponse(rsp)
+
+	response := &GetAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetAllWebhooksWithResponse request returning *GetAllWebhooksResponse
-func (c *ClientWithResponses) GetAllWebhooksWithResponse(ctx context.Context, spaceId SpaceId, params *GetAllWebhooksParams, reqEditors ...RequestEditorFn) (*GetAllWebhooksResponse, error) {
-	rsp, err := c.GetAllWebhooks(ctx, spaceId, params, reqEditors...)
+// ParseUpdateAppDefinitionResponse parses an HTTP response from a UpdateAppDefinitionWithResponse call
+func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetAllWebhooksResponse(rsp)
+
+	response := &UpdateAppDefinitionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppDefinition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
-func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
-	rsp, err := c.CreateWebhookWithBody(ctx, spaceId, contentType, body, reqEditors...)
+// ParseCreateAppBundleResponse parses an HTTP response from a CreateAppBundleWithResponse call
+func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateWebhookResponse(rsp)
+
+	response := &CreateAppBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Sys SystemPropertiesBase `json:"sys"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, spaceId SpaceId, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
-	rsp, err := c.CreateWebhook(ctx, spaceId, body, reqEditors...)
+// ParseDeleteAppEventSubscriptionResponse parses an HTTP response from a DeleteAppEventSubscriptionWithResponse call
+func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateWebhookResponse(rsp)
+
+	response := &DeleteAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
-func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
-	rsp, err := c.DeleteWebhook(ctx, spaceId, webhookId, params, reqEditors...)
+// ParseGetAppEventSubscriptionResponse parses an HTTP response from a GetAppEventSubscriptionWithResponse call
+func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteWebhookResponse(rsp)
+
+	response := &GetAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// GetWebhookWithResponse request returning *GetWebhookResponse
-func (c *ClientWithResponses) GetWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, reqEditors ...RequestEditorFn) (*GetWebhookResponse, error) {
-	rsp, err := c.GetWebhook(ctx, spaceId, webhookId, reqEditors...)
+// ParseUpdateAppEventSubscriptionResponse parses an HTTP response from a UpdateAppEventSubscriptionWithResponse call
+func ParseUpdateAppEventSubscriptionResponse(rsp *http.Response) (*UpdateAppEventSubscriptionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWebhookResponse(rsp)
+
+	response := &UpdateAppEventSubscriptionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppEventSubscription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// UpdateWebhookWithBodyWithResponse request with arbitrary body returning *UpdateWebhookResponse
-func (c *ClientWithResponses) UpdateWebhookWithBodyWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
-	rsp, err := c.UpdateWebhookWithBody(ctx, spaceId, webhookId, params, contentType, body, reqEditors...)
+// ParseUploadAppResponse parses an HTTP response from a UploadAppWithResponse call
+func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateWebhookResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateWebhookWithResponse(ctx context.Context, spaceId SpaceId, webhookId WebhookId, params *UpdateWebhookParams, body UpdateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateWebhookResponse, error) {
-	rsp, err := c.UpdateWebhook(ctx, spaceId, webhookId, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UploadAppResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseUpdateWebhookResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Sys SystemPropertiesBase `json:"sys"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetAllAppDefinitionsResponse parses an HTTP response from a GetAllAppDefinitionsWithResponse call
-func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinitionsResponse, error) {
+// ParseGetAllSpacesResponse parses an HTTP response from a GetAllSpacesWithResponse call
+func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAppDefinitionsResponse{
+	response := &GetAllSpacesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinitionCollection
+		var dest SpaceCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11467,22 +14562,22 @@ func ParseGetAllAppDefinitionsResponse(rsp *http.Response) (*GetAllAppDefinition
 	return response, nil
 }
 
-// ParseCreateAppDefinitionResponse parses an HTTP response from a CreateAppDefinitionWithResponse call
-func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionResponse, error) {
+// ParseCreateSpaceResponse parses an HTTP response from a CreateSpaceWithResponse call
+func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppDefinitionResponse{
+	response := &CreateSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11493,15 +14588,15 @@ func ParseCreateAppDefinitionResponse(rsp *http.Response) (*CreateAppDefinitionR
 	return response, nil
 }
 
-// ParseDeleteAppDefinitionResponse parses an HTTP response from a DeleteAppDefinitionWithResponse call
-func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionResponse, error) {
+// ParseDeleteSpaceResponse parses an HTTP response from a DeleteSpaceWithResponse call
+func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppDefinitionResponse{
+	response := &DeleteSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11509,22 +14604,22 @@ func ParseDeleteAppDefinitionResponse(rsp *http.Response) (*DeleteAppDefinitionR
 	return response, nil
 }
 
-// ParseGetAppDefinitionResponse parses an HTTP response from a GetAppDefinitionWithResponse call
-func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionResponse, error) {
+// ParseGetSpaceResponse parses an HTTP response from a GetSpaceWithResponse call
+func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppDefinitionResponse{
+	response := &GetSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11535,22 +14630,22 @@ func ParseGetAppDefinitionResponse(rsp *http.Response) (*GetAppDefinitionRespons
 	return response, nil
 }
 
-// ParseUpdateAppDefinitionResponse parses an HTTP response from a UpdateAppDefinitionWithResponse call
-func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionResponse, error) {
+// ParseUpdateSpaceResponse parses an HTTP response from a UpdateSpaceWithResponse call
+func ParseUpdateSpaceResponse(rsp *http.Response) (*UpdateSpaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppDefinitionResponse{
+	response := &UpdateSpaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppDefinition
+		var dest Space
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11561,24 +14656,48 @@ func ParseUpdateAppDefinitionResponse(rsp *http.Response) (*UpdateAppDefinitionR
 	return response, nil
 }
 
-// ParseCreateAppBundleResponse parses an HTTP response from a CreateAppBundleWithResponse call
-func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse, error) {
+// ParseGetAllApiKeysResponse parses an HTTP response from a GetAllApiKeysWithResponse call
+func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAppBundleResponse{
+	response := &GetAllApiKeysResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Sys SystemPropertiesBase `json:"sys"`
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ApiKeyCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
+func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateApiKeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11589,15 +14708,15 @@ func ParseCreateAppBundleResponse(rsp *http.Response) (*CreateAppBundleResponse,
 	return response, nil
 }
 
-// ParseDeleteAppEventSubscriptionResponse parses an HTTP response from a DeleteAppEventSubscriptionWithResponse call
-func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEventSubscriptionResponse, error) {
+// ParseDeleteApiKeyResponse parses an HTTP response from a DeleteApiKeyWithResponse call
+func ParseDeleteApiKeyResponse(rsp *http.Response) (*DeleteApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppEventSubscriptionResponse{
+	response := &DeleteApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11605,22 +14724,22 @@ func ParseDeleteAppEventSubscriptionResponse(rsp *http.Response) (*DeleteAppEven
 	return response, nil
 }
 
-// ParseGetAppEventSubscriptionResponse parses an HTTP response from a GetAppEventSubscriptionWithResponse call
-func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubscriptionResponse, error) {
+// ParseGetApiKeyResponse parses an HTTP response from a GetApiKeyWithResponse call
+func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppEventSubscriptionResponse{
+	response := &GetApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppEventSubscription
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11631,57 +14750,74 @@ func ParseGetAppEventSubscriptionResponse(rsp *http.Response) (*GetAppEventSubsc
 	return response, nil
 }
 
-// ParseUpdateAppEventSubscriptionResponse parses an HTTP response from a UpdateAppEventSubscriptionWithResponse call
-func ParseUpdateAppEventSubscriptionResponse(rsp *http.Response) (*UpdateAppEventSubscriptionResponse, error) {
+// ParseUpdateApiKeyResponse parses an HTTP response from a UpdateApiKeyWithResponse call
+func ParseUpdateApiKeyResponse(rsp *http.Response) (*UpdateApiKeyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAppEventSubscriptionResponse{
+	response := &UpdateApiKeyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppEventSubscription
+		var dest ApiKey
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppEventSubscription
+	}
+
+	return response, nil
+}
+
+// ParseGetAllEnvironmentsResponse parses an HTTP response from a GetAllEnvironmentsWithResponse call
+func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllEnvironmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EnvironmentCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUploadAppResponse parses an HTTP response from a UploadAppWithResponse call
-func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
+// ParseCreateEnvironmentResponse parses an HTTP response from a CreateEnvironmentWithResponse call
+func ParseCreateEnvironmentResponse(rsp *http.Response) (*CreateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UploadAppResponse{
+	response := &CreateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest struct {
-			Sys SystemPropertiesBase `json:"sys"`
-		}
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11692,22 +14828,38 @@ func ParseUploadAppResponse(rsp *http.Response) (*UploadAppResponse, error) {
 	return response, nil
 }
 
-// ParseGetAllSpacesResponse parses an HTTP response from a GetAllSpacesWithResponse call
-func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error) {
+// ParseDeleteEnvironmentResponse parses an HTTP response from a DeleteEnvironmentWithResponse call
+func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllSpacesResponse{
+	response := &DeleteEnvironmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
+func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SpaceCollection
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11718,64 +14870,90 @@ func ParseGetAllSpacesResponse(rsp *http.Response) (*GetAllSpacesResponse, error
 	return response, nil
 }
 
-// ParseCreateSpaceResponse parses an HTTP response from a CreateSpaceWithResponse call
-func ParseCreateSpaceResponse(rsp *http.Response) (*CreateSpaceResponse, error) {
+// ParseUpdateEnvironmentResponse parses an HTTP response from a UpdateEnvironmentWithResponse call
+func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateSpaceResponse{
+	response := &UpdateEnvironmentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Space
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Environment
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteSpaceResponse parses an HTTP response from a DeleteSpaceWithResponse call
-func ParseDeleteSpaceResponse(rsp *http.Response) (*DeleteSpaceResponse, error) {
+// ParseGetAllAppInstallationsResponse parses an HTTP response from a GetAllAppInstallationsWithResponse call
+func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstallationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteSpaceResponse{
+	response := &GetAllAppInstallationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AppInstallationCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetSpaceResponse parses an HTTP response from a GetSpaceWithResponse call
-func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
+// ParseDeleteAppInstallationResponse parses an HTTP response from a DeleteAppInstallationWithResponse call
+func ParseDeleteAppInstallationResponse(rsp *http.Response) (*DeleteAppInstallationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSpaceResponse{
+	response := &DeleteAppInstallationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAppInstallationResponse parses an HTTP response from a GetAppInstallationWithResponse call
+func ParseGetAppInstallationResponse(rsp *http.Response) (*GetAppInstallationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAppInstallationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Space
+		var dest AppInstallation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11786,48 +14964,55 @@ func ParseGetSpaceResponse(rsp *http.Response) (*GetSpaceResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateSpaceResponse parses an HTTP response from a UpdateSpaceWithResponse call
-func ParseUpdateSpaceResponse(rsp *http.Response) (*UpdateSpaceResponse, error) {
+// ParseUpsertAppInstallationResponse parses an HTTP response from a UpsertAppInstallationWithResponse call
+func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateSpaceResponse{
+	response := &UpsertAppInstallationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Space
+		var dest AppInstallation
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AppInstallation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
-
-// ParseGetAllApiKeysResponse parses an HTTP response from a GetAllApiKeysWithResponse call
-func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, error) {
+
+// ParseGetAllAssetsResponse parses an HTTP response from a GetAllAssetsWithResponse call
+func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllApiKeysResponse{
+	response := &GetAllAssetsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKeyCollection
+		var dest AssetCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11838,22 +15023,22 @@ func ParseGetAllApiKeysResponse(rsp *http.Response) (*GetAllApiKeysResponse, err
 	return response, nil
 }
 
-// ParseCreateApiKeyResponse parses an HTTP response from a CreateApiKeyWithResponse call
-func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error) {
+// ParseCreateAssetResponse parses an HTTP response from a CreateAssetWithResponse call
+func ParseCreateAssetResponse(rsp *http.Response) (*CreateAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateApiKeyResponse{
+	response := &CreateAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ApiKey
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11864,15 +15049,15 @@ func ParseCreateApiKeyResponse(rsp *http.Response) (*CreateApiKeyResponse, error
 	return response, nil
 }
 
-// ParseDeleteApiKeyResponse parses an HTTP response from a DeleteApiKeyWithResponse call
-func ParseDeleteApiKeyResponse(rsp *http.Response) (*DeleteApiKeyResponse, error) {
+// ParseDeleteAssetResponse parses an HTTP response from a DeleteAssetWithResponse call
+func ParseDeleteAssetResponse(rsp *http.Response) (*DeleteAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteApiKeyResponse{
+	response := &DeleteAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -11880,22 +15065,22 @@ func ParseDeleteApiKeyResponse(rsp *http.Response) (*DeleteApiKeyResponse, error
 	return response, nil
 }
 
-// ParseGetApiKeyResponse parses an HTTP response from a GetApiKeyWithResponse call
-func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
+// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
+func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetApiKeyResponse{
+	response := &GetAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKey
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11906,48 +15091,55 @@ func ParseGetApiKeyResponse(rsp *http.Response) (*GetApiKeyResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateApiKeyResponse parses an HTTP response from a UpdateApiKeyWithResponse call
-func ParseUpdateApiKeyResponse(rsp *http.Response) (*UpdateApiKeyResponse, error) {
+// ParseUpdateAssetResponse parses an HTTP response from a UpdateAssetWithResponse call
+func ParseUpdateAssetResponse(rsp *http.Response) (*UpdateAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateApiKeyResponse{
+	response := &UpdateAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ApiKey
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Asset
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAllEnvironmentsResponse parses an HTTP response from a GetAllEnvironmentsWithResponse call
-func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsResponse, error) {
+// ParseUnarchiveAssetResponse parses an HTTP response from a UnarchiveAssetWithResponse call
+func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllEnvironmentsResponse{
+	response := &UnarchiveAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EnvironmentCollection
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -11958,41 +15150,41 @@ func ParseGetAllEnvironmentsResponse(rsp *http.Response) (*GetAllEnvironmentsRes
 	return response, nil
 }
 
-// ParseCreateEnvironmentResponse parses an HTTP response from a CreateEnvironmentWithResponse call
-func ParseCreateEnvironmentResponse(rsp *http.Response) (*CreateEnvironmentResponse, error) {
+// ParseArchiveAssetResponse parses an HTTP response from a ArchiveAssetWithResponse call
+func ParseArchiveAssetResponse(rsp *http.Response) (*ArchiveAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEnvironmentResponse{
+	response := &ArchiveAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Environment
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteEnvironmentResponse parses an HTTP response from a DeleteEnvironmentWithResponse call
-func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentResponse, error) {
+// ParseProcessAssetResponse parses an HTTP response from a ProcessAssetWithResponse call
+func ParseProcessAssetResponse(rsp *http.Response) (*ProcessAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEnvironmentResponse{
+	response := &ProcessAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12000,22 +15192,22 @@ func ParseDeleteEnvironmentResponse(rsp *http.Response) (*DeleteEnvironmentRespo
 	return response, nil
 }
 
-// ParseGetEnvironmentResponse parses an HTTP response from a GetEnvironmentWithResponse call
-func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, error) {
+// ParseUnpublishAssetResponse parses an HTTP response from a UnpublishAssetWithResponse call
+func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEnvironmentResponse{
+	response := &UnpublishAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12026,22 +15218,22 @@ func ParseGetEnvironmentResponse(rsp *http.Response) (*GetEnvironmentResponse, e
 	return response, nil
 }
 
-// ParseUpdateEnvironmentResponse parses an HTTP response from a UpdateEnvironmentWithResponse call
-func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentResponse, error) {
+// ParsePublishAssetResponse parses an HTTP response from a PublishAssetWithResponse call
+func ParsePublishAssetResponse(rsp *http.Response) (*PublishAssetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEnvironmentResponse{
+	response := &PublishAssetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Environment
+		var dest Asset
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12052,22 +15244,22 @@ func ParseUpdateEnvironmentResponse(rsp *http.Response) (*UpdateEnvironmentRespo
 	return response, nil
 }
 
-// ParseGetAllAppInstallationsResponse parses an HTTP response from a GetAllAppInstallationsWithResponse call
-func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstallationsResponse, error) {
+// ParseGetBulkActionResponse parses an HTTP response from a GetBulkActionWithResponse call
+func ParseGetBulkActionResponse(rsp *http.Response) (*GetBulkActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAppInstallationsResponse{
+	response := &GetBulkActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallationCollection
+		var dest BulkAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12078,71 +15270,74 @@ func ParseGetAllAppInstallationsResponse(rsp *http.Response) (*GetAllAppInstalla
 	return response, nil
 }
 
-// ParseDeleteAppInstallationResponse parses an HTTP response from a DeleteAppInstallationWithResponse call
-func ParseDeleteAppInstallationResponse(rsp *http.Response) (*DeleteAppInstallationResponse, error) {
+// ParsePublishBulkActionResponse parses an HTTP response from a PublishBulkActionWithResponse call
+func ParsePublishBulkActionResponse(rsp *http.Response) (*PublishBulkActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAppInstallationResponse{
+	response := &PublishBulkActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkAction
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetAppInstallationResponse parses an HTTP response from a GetAppInstallationWithResponse call
-func ParseGetAppInstallationResponse(rsp *http.Response) (*GetAppInstallationResponse, error) {
+// ParseUnpublishBulkActionResponse parses an HTTP response from a UnpublishBulkActionWithResponse call
+func ParseUnpublishBulkActionResponse(rsp *http.Response) (*UnpublishBulkActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAppInstallationResponse{
+	response := &UnpublishBulkActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallation
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BulkAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseUpsertAppInstallationResponse parses an HTTP response from a UpsertAppInstallationWithResponse call
-func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallationResponse, error) {
+// ParseValidateBulkActionResponse parses an HTTP response from a ValidateBulkActionWithResponse call
+func ParseValidateBulkActionResponse(rsp *http.Response) (*ValidateBulkActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpsertAppInstallationResponse{
+	response := &ValidateBulkActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AppInstallation
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest AppInstallation
+		var dest BulkAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12153,22 +15348,22 @@ func ParseUpsertAppInstallationResponse(rsp *http.Response) (*UpsertAppInstallat
 	return response, nil
 }
 
-// ParseGetAllAssetsResponse parses an HTTP response from a GetAllAssetsWithResponse call
-func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error) {
+// ParseGetAllContentTypesResponse parses an HTTP response from a GetAllContentTypesWithResponse call
+func ParseGetAllContentTypesResponse(rsp *http.Response) (*GetAllContentTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllAssetsResponse{
+	response := &GetAllContentTypesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AssetCollection
+		var dest ContentTypeCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12179,22 +15374,22 @@ func ParseGetAllAssetsResponse(rsp *http.Response) (*GetAllAssetsResponse, error
 	return response, nil
 }
 
-// ParseCreateAssetResponse parses an HTTP response from a CreateAssetWithResponse call
-func ParseCreateAssetResponse(rsp *http.Response) (*CreateAssetResponse, error) {
+// ParseCreateContentTypeResponse parses an HTTP response from a CreateContentTypeWithResponse call
+func ParseCreateContentTypeResponse(rsp *http.Response) (*CreateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateAssetResponse{
+	response := &CreateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12205,15 +15400,15 @@ func ParseCreateAssetResponse(rsp *http.Response) (*CreateAssetResponse, error)
 	return response, nil
 }
 
-// ParseDeleteAssetResponse parses an HTTP response from a DeleteAssetWithResponse call
-func ParseDeleteAssetResponse(rsp *http.Response) (*DeleteAssetResponse, error) {
+// ParseDeleteContentTypeResponse parses an HTTP response from a DeleteContentTypeWithResponse call
+func ParseDeleteContentTypeResponse(rsp *http.Response) (*DeleteContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteAssetResponse{
+	response := &DeleteContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12221,22 +15416,22 @@ func ParseDeleteAssetResponse(rsp *http.Response) (*DeleteAssetResponse, error)
 	return response, nil
 }
 
-// ParseGetAssetResponse parses an HTTP response from a GetAssetWithResponse call
-func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
+// ParseGetContentTypeResponse parses an HTTP response from a GetContentTypeWithResponse call
+func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAssetResponse{
+	response := &GetContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12247,29 +15442,29 @@ func ParseGetAssetResponse(rsp *http.Response) (*GetAssetResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateAssetResponse parses an HTTP response from a UpdateAssetWithResponse call
-func ParseUpdateAssetResponse(rsp *http.Response) (*UpdateAssetResponse, error) {
+// ParseUpdateContentTypeResponse parses an HTTP response from a UpdateContentTypeWithResponse call
+func ParseUpdateContentTypeResponse(rsp *http.Response) (*UpdateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateAssetResponse{
+	response := &UpdateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12280,22 +15475,22 @@ func ParseUpdateAssetResponse(rsp *http.Response) (*UpdateAssetResponse, error)
 	return response, nil
 }
 
-// ParseUnarchiveAssetResponse parses an HTTP response from a UnarchiveAssetWithResponse call
-func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, error) {
+// ParseGetEditorInterfaceResponse parses an HTTP response from a GetEditorInterfaceWithResponse call
+func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnarchiveAssetResponse{
+	response := &GetEditorInterfaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest EditorInterface
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12306,22 +15501,22 @@ func ParseUnarchiveAssetResponse(rsp *http.Response) (*UnarchiveAssetResponse, e
 	return response, nil
 }
 
-// ParseArchiveAssetResponse parses an HTTP response from a ArchiveAssetWithResponse call
-func ParseArchiveAssetResponse(rsp *http.Response) (*ArchiveAssetResponse, error) {
+// ParseUpdateEditorInterfaceResponse parses an HTTP response from a UpdateEditorInterfaceWithResponse call
+func ParseUpdateEditorInterfaceResponse(rsp *http.Response) (*UpdateEditorInterfaceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveAssetResponse{
+	response := &UpdateEditorInterfaceResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest EditorInterface
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12332,38 +15527,48 @@ func ParseArchiveAssetResponse(rsp *http.Response) (*ArchiveAssetResponse, error
 	return response, nil
 }
 
-// ParseProcessAssetResponse parses an HTTP response from a ProcessAssetWithResponse call
-func ParseProcessAssetResponse(rsp *http.Response) (*ProcessAssetResponse, error) {
+// ParseDeactivateContentTypeResponse parses an HTTP response from a DeactivateContentTypeWithResponse call
+func ParseDeactivateContentTypeResponse(rsp *http.Response) (*DeactivateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ProcessAssetResponse{
+	response := &DeactivateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ContentType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseUnpublishAssetResponse parses an HTTP response from a UnpublishAssetWithResponse call
-func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, error) {
+// ParseActivateContentTypeResponse parses an HTTP response from a ActivateContentTypeWithResponse call
+func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnpublishAssetResponse{
+	response := &ActivateContentTypeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest ContentType
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12374,22 +15579,22 @@ func ParseUnpublishAssetResponse(rsp *http.Response) (*UnpublishAssetResponse, e
 	return response, nil
 }
 
-// ParsePublishAssetResponse parses an HTTP response from a PublishAssetWithResponse call
-func ParsePublishAssetResponse(rsp *http.Response) (*PublishAssetResponse, error) {
+// ParseGetAllEntriesResponse parses an HTTP response from a GetAllEntriesWithResponse call
+func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PublishAssetResponse{
+	response := &GetAllEntriesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Asset
+		var dest EntryCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12400,90 +15605,90 @@ func ParsePublishAssetResponse(rsp *http.Response) (*PublishAssetResponse, error
 	return response, nil
 }
 
-// ParseGetAllContentTypesResponse parses an HTTP response from a GetAllContentTypesWithResponse call
-func ParseGetAllContentTypesResponse(rsp *http.Response) (*GetAllContentTypesResponse, error) {
+// ParseCreateEntryResponse parses an HTTP response from a CreateEntryWithResponse call
+func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllContentTypesResponse{
+	response := &CreateEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentTypeCollection
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateContentTypeResponse parses an HTTP response from a CreateContentTypeWithResponse call
-func ParseCreateContentTypeResponse(rsp *http.Response) (*CreateContentTypeResponse, error) {
+// ParseDeleteEntryResponse parses an HTTP response from a DeleteEntryWithResponse call
+func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateContentTypeResponse{
+	response := &DeleteEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ContentType
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseDeleteContentTypeResponse parses an HTTP response from a DeleteContentTypeWithResponse call
-func ParseDeleteContentTypeResponse(rsp *http.Response) (*DeleteContentTypeResponse, error) {
+// ParseGetEntryResponse parses an HTTP response from a GetEntryWithResponse call
+func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteContentTypeResponse{
+	response := &GetEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Entry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
 	return response, nil
 }
-
-// ParseGetContentTypeResponse parses an HTTP response from a GetContentTypeWithResponse call
-func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, error) {
+
+// ParsePatchEntryResponse parses an HTTP response from a PatchEntryWithResponse call
+func ParsePatchEntryResponse(rsp *http.Response) (*PatchEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetContentTypeResponse{
+	response := &PatchEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12494,29 +15699,29 @@ func ParseGetContentTypeResponse(rsp *http.Response) (*GetContentTypeResponse, e
 	return response, nil
 }
 
-// ParseUpdateContentTypeResponse parses an HTTP response from a UpdateContentTypeWithResponse call
-func ParseUpdateContentTypeResponse(rsp *http.Response) (*UpdateContentTypeResponse, error) {
+// ParseUpdateEntryResponse parses an HTTP response from a UpdateEntryWithResponse call
+func ParseUpdateEntryResponse(rsp *http.Response) (*UpdateEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateContentTypeResponse{
+	response := &UpdateEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12527,22 +15732,22 @@ func ParseUpdateContentTypeResponse(rsp *http.Response) (*UpdateContentTypeRespo
 	return response, nil
 }
 
-// ParseGetEditorInterfaceResponse parses an HTTP response from a GetEditorInterfaceWithResponse call
-func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceResponse, error) {
+// ParseUnarchiveEntryResponse parses an HTTP response from a UnarchiveEntryWithResponse call
+func ParseUnarchiveEntryResponse(rsp *http.Response) (*UnarchiveEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEditorInterfaceResponse{
+	response := &UnarchiveEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EditorInterface
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12553,22 +15758,22 @@ func ParseGetEditorInterfaceResponse(rsp *http.Response) (*GetEditorInterfaceRes
 	return response, nil
 }
 
-// ParseUpdateEditorInterfaceResponse parses an HTTP response from a UpdateEditorInterfaceWithResponse call
-func ParseUpdateEditorInterfaceResponse(rsp *http.Response) (*UpdateEditorInterfaceResponse, error) {
+// ParseArchiveEntryResponse parses an HTTP response from a ArchiveEntryWithResponse call
+func ParseArchiveEntryResponse(rsp *http.Response) (*ArchiveEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEditorInterfaceResponse{
+	response := &ArchiveEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EditorInterface
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12579,22 +15784,22 @@ func ParseUpdateEditorInterfaceResponse(rsp *http.Response) (*UpdateEditorInterf
 	return response, nil
 }
 
-// ParseDeactivateContentTypeResponse parses an HTTP response from a DeactivateContentTypeWithResponse call
-func ParseDeactivateContentTypeResponse(rsp *http.Response) (*DeactivateContentTypeResponse, error) {
+// ParseUnpublishEntryResponse parses an HTTP response from a UnpublishEntryWithResponse call
+func ParseUnpublishEntryResponse(rsp *http.Response) (*UnpublishEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeactivateContentTypeResponse{
+	response := &UnpublishEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12605,22 +15810,22 @@ func ParseDeactivateContentTypeResponse(rsp *http.Response) (*DeactivateContentT
 	return response, nil
 }
 
-// ParseActivateContentTypeResponse parses an HTTP response from a ActivateContentTypeWithResponse call
-func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeResponse, error) {
+// ParsePublishEntryResponse parses an HTTP response from a PublishEntryWithResponse call
+func ParsePublishEntryResponse(rsp *http.Response) (*PublishEntryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ActivateContentTypeResponse{
+	response := &PublishEntryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ContentType
+		var dest Entry
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12631,22 +15836,22 @@ func ParseActivateContentTypeResponse(rsp *http.Response) (*ActivateContentTypeR
 	return response, nil
 }
 
-// ParseGetAllEntriesResponse parses an HTTP response from a GetAllEntriesWithResponse call
-func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, error) {
+// ParseGetAllLocalesResponse parses an HTTP response from a GetAllLocalesWithResponse call
+func ParseGetAllLocalesResponse(rsp *http.Response) (*GetAllLocalesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllEntriesResponse{
+	response := &GetAllLocalesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest EntryCollection
+		var dest LocaleCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12657,22 +15862,22 @@ func ParseGetAllEntriesResponse(rsp *http.Response) (*GetAllEntriesResponse, err
 	return response, nil
 }
 
-// ParseCreateEntryResponse parses an HTTP response from a CreateEntryWithResponse call
-func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error) {
+// ParseCreateLocaleResponse parses an HTTP response from a CreateLocaleWithResponse call
+func ParseCreateLocaleResponse(rsp *http.Response) (*CreateLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateEntryResponse{
+	response := &CreateLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Entry
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12683,15 +15888,15 @@ func ParseCreateEntryResponse(rsp *http.Response) (*CreateEntryResponse, error)
 	return response, nil
 }
 
-// ParseDeleteEntryResponse parses an HTTP response from a DeleteEntryWithResponse call
-func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error) {
+// ParseDeleteLocaleResponse parses an HTTP response from a DeleteLocaleWithResponse call
+func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteEntryResponse{
+	response := &DeleteLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12699,22 +15904,22 @@ func ParseDeleteEntryResponse(rsp *http.Response) (*DeleteEntryResponse, error)
 	return response, nil
 }
 
-// ParseGetEntryResponse parses an HTTP response from a GetEntryWithResponse call
-func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
+// ParseGetLocaleResponse parses an HTTP response from a GetLocaleWithResponse call
+func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetEntryResponse{
+	response := &GetLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12725,81 +15930,90 @@ func ParseGetEntryResponse(rsp *http.Response) (*GetEntryResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateEntryResponse parses an HTTP response from a UpdateEntryWithResponse call
-func ParseUpdateEntryResponse(rsp *http.Response) (*UpdateEntryResponse, error) {
+// ParseUpdateLocaleResponse parses an HTTP response from a UpdateLocaleWithResponse call
+func ParseUpdateLocaleResponse(rsp *http.Response) (*UpdateLocaleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateEntryResponse{
+	response := &UpdateLocaleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Locale
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Entry
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseUnarchiveEntryResponse parses an HTTP response from a UnarchiveEntryWithResponse call
-func ParseUnarchiveEntryResponse(rsp *http.Response) (*UnarchiveEntryResponse, error) {
+// ParseCreateReleaseResponse parses an HTTP response from a CreateReleaseWithResponse call
+func ParseCreateReleaseResponse(rsp *http.Response) (*CreateReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnarchiveEntryResponse{
+	response := &CreateReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON201 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseArchiveEntryResponse parses an HTTP response from a ArchiveEntryWithResponse call
-func ParseArchiveEntryResponse(rsp *http.Response) (*ArchiveEntryResponse, error) {
+// ParseDeleteReleaseResponse parses an HTTP response from a DeleteReleaseWithResponse call
+func ParseDeleteReleaseResponse(rsp *http.Response) (*DeleteReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ArchiveEntryResponse{
+	response := &DeleteReleaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetReleaseResponse parses an HTTP response from a GetReleaseWithResponse call
+func ParseGetReleaseResponse(rsp *http.Response) (*GetReleaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12810,22 +16024,22 @@ func ParseArchiveEntryResponse(rsp *http.Response) (*ArchiveEntryResponse, error
 	return response, nil
 }
 
-// ParseUnpublishEntryResponse parses an HTTP response from a UnpublishEntryWithResponse call
-func ParseUnpublishEntryResponse(rsp *http.Response) (*UnpublishEntryResponse, error) {
+// ParseUpdateReleaseResponse parses an HTTP response from a UpdateReleaseWithResponse call
+func ParseUpdateReleaseResponse(rsp *http.Response) (*UpdateReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UnpublishEntryResponse{
+	response := &UpdateReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest Release
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12836,22 +16050,22 @@ func ParseUnpublishEntryResponse(rsp *http.Response) (*UnpublishEntryResponse, e
 	return response, nil
 }
 
-// ParsePublishEntryResponse parses an HTTP response from a PublishEntryWithResponse call
-func ParsePublishEntryResponse(rsp *http.Response) (*PublishEntryResponse, error) {
+// ParseGetReleaseActionResponse parses an HTTP response from a GetReleaseActionWithResponse call
+func ParseGetReleaseActionResponse(rsp *http.Response) (*GetReleaseActionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PublishEntryResponse{
+	response := &GetReleaseActionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Entry
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12862,67 +16076,67 @@ func ParsePublishEntryResponse(rsp *http.Response) (*PublishEntryResponse, error
 	return response, nil
 }
 
-// ParseGetAllLocalesResponse parses an HTTP response from a GetAllLocalesWithResponse call
-func ParseGetAllLocalesResponse(rsp *http.Response) (*GetAllLocalesResponse, error) {
+// ParsePublishReleaseResponse parses an HTTP response from a PublishReleaseWithResponse call
+func ParsePublishReleaseResponse(rsp *http.Response) (*PublishReleaseResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllLocalesResponse{
+	response := &PublishReleaseResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LocaleCollection
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ReleaseAction
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseCreateLocaleResponse parses an HTTP response from a CreateLocaleWithResponse call
-func ParseCreateLocaleResponse(rsp *http.Response) (*CreateLocaleResponse, error) {
+// ParseGetAllTagsResponse parses an HTTP response from a GetAllTagsWithResponse call
+func ParseGetAllTagsResponse(rsp *http.Response) (*GetAllTagsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateLocaleResponse{
+	response := &GetAllTagsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Locale
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TagCollection
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseDeleteLocaleResponse parses an HTTP response from a DeleteLocaleWithResponse call
-func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error) {
+// ParseDeleteTagResponse parses an HTTP response from a DeleteTagWithResponse call
+func ParseDeleteTagResponse(rsp *http.Response) (*DeleteTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteLocaleResponse{
+	response := &DeleteTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -12930,22 +16144,22 @@ func ParseDeleteLocaleResponse(rsp *http.Response) (*DeleteLocaleResponse, error
 	return response, nil
 }
 
-// ParseGetLocaleResponse parses an HTTP response from a GetLocaleWithResponse call
-func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
+// ParseGetTagResponse parses an HTTP response from a GetTagWithResponse call
+func ParseGetTagResponse(rsp *http.Response) (*GetTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLocaleResponse{
+	response := &GetTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Locale
+		var dest Tag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -12956,27 +16170,34 @@ func ParseGetLocaleResponse(rsp *http.Response) (*GetLocaleResponse, error) {
 	return response, nil
 }
 
-// ParseUpdateLocaleResponse parses an HTTP response from a UpdateLocaleWithResponse call
-func ParseUpdateLocaleResponse(rsp *http.Response) (*UpdateLocaleResponse, error) {
+// ParseUpdateTagResponse parses an HTTP response from a UpdateTagWithResponse call
+func ParseUpdateTagResponse(rsp *http.Response) (*UpdateTagResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateLocaleResponse{
+	response := &UpdateTagResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Locale
+		var dest Tag
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Tag
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
 	return response, nil
@@ -13367,3 +16588,123 @@ func ParseUpdateWebhookResponse(rsp *http.Response) (*UpdateWebhookResponse, err
 
 	return response, nil
 }
+
+// ParseDeleteWebhookSigningSecretResponse parses an HTTP response from a DeleteWebhookSigningSecretWithResponse call
+func ParseDeleteWebhookSigningSecretResponse(rsp *http.Response) (*DeleteWebhookSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookSigningSecretResponse parses an HTTP response from a GetWebhookSigningSecretWithResponse call
+func ParseGetWebhookSigningSecretResponse(rsp *http.Response) (*GetWebhookSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSigningSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateWebhookSigningSecretResponse parses an HTTP response from a UpdateWebhookSigningSecretWithResponse call
+func ParseUpdateWebhookSigningSecretResponse(rsp *http.Response) (*UpdateWebhookSigningSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateWebhookSigningSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookSigningSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookCallsResponse parses an HTTP response from a GetWebhookCallsWithResponse call
+func ParseGetWebhookCallsResponse(rsp *http.Response) (*GetWebhookCallsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookCallsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookCallCollection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookHealthResponse parses an HTTP response from a GetWebhookHealthWithResponse call
+func ParseGetWebhookHealthResponse(rsp *http.Response) (*GetWebhookHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookHealth
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
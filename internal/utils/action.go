@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v5"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// The status of an asynchronous action, like a bulk action or a release action
+const (
+	ActionSucceeded = "succeeded"
+	ActionFailed    = "failed"
+)
+
+// actionTimeout is the maximum time to wait for an action to finish
+const actionTimeout = 5 * time.Minute
+
+var errActionPending = errors.New("action is not finished yet")
+
+// WaitForAction polls an asynchronous action until it succeeded or failed.
+// The status function returns the status of the action and get fetches the
+// current state of the action.
+func WaitForAction[T any](ctx context.Context, action *T, status func(*T) string, get func() (*T, error)) (*T, error) {
+	return backoff.Retry(ctx, func() (*T, error) {
+		if isActionFinished(status(action)) {
+			return action, nil
+		}
+
+		current, err := get()
+		if err != nil {
+			return nil, backoff.Permanent(err)
+		}
+
+		action = current
+		if isActionFinished(status(action)) {
+			return action, nil
+		}
+		return nil, errActionPending
+	}, backoff.WithMaxElapsedTime(actionTimeout), backoff.WithBackOff(backoff.NewExponentialBackOff()))
+}
+
+func isActionFinished(status string) bool {
+	return status == ActionSucceeded || status == ActionFailed
+}
+
+// ActionEntityError is an error of a failed action. Entity is nil when the
+// error is not about a single entity.
+type ActionEntityError struct {
+	Entity  *sdk.VersionedLink
+	Message string
+}
+
+// ActionErrors returns the errors of a failed action, including the details
+// of the error per entity. A single error without entity is returned when
+// Contentful doesn't report the errors per entity.
+func ActionErrors(actionError *sdk.BulkActionError) []ActionEntityError {
+	if actionError == nil || actionError.Details == nil || actionError.Details.Errors == nil || len(*actionError.Details.Errors) == 0 {
+		message := "unknown error"
+		if actionError != nil && actionError.Message != nil {
+			message = *actionError.Message
+		}
+		return []ActionEntityError{{Message: message}}
+	}
+
+	result := make([]ActionEntityError, 0, len(*actionError.Details.Errors))
+	for _, entityError := range *actionError.Details.Errors {
+		message := "unknown error"
+		if entityError.Error != nil && entityError.Error.Message != nil {
+			message = *entityError.Error.Message
+		}
+		if entityError.Error != nil && entityError.Error.Details != nil {
+			if details, err := json.Marshal(*entityError.Error.Details); err == nil {
+				message = fmt.Sprintf("%s %s", message, details)
+			}
+		}
+
+		result = append(result, ActionEntityError{Entity: entityError.Entity, Message: message})
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+func TestWaitForAction(t *testing.T) {
+	status := func(action *string) string { return *action }
+
+	calls := 0
+	action, err := WaitForAction(context.Background(), Pointer("inProgress"), status, func() (*string, error) {
+		calls++
+		if calls < 2 {
+			return Pointer("inProgress"), nil
+		}
+		return Pointer(ActionFailed), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ActionFailed, *action)
+	assert.Equal(t, 2, calls)
+
+	action, err = WaitForAction(context.Background(), Pointer(ActionSucceeded), status, func() (*string, error) {
+		t.Fatal("finished action should not be fetched")
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ActionSucceeded, *action)
+
+	_, err = WaitForAction(context.Background(), Pointer("inProgress"), status, func() (*string, error) {
+		return nil, errors.New("not found")
+	})
+	assert.EqualError(t, err, "not found")
+}
+
+func TestActionErrors(t *testing.T) {
+	assert.Equal(t, []ActionEntityError{{Message: "unknown error"}}, ActionErrors(nil))
+	assert.Equal(t, []ActionEntityError{{Message: "timeout"}}, ActionErrors(&sdk.BulkActionError{Message: Pointer("timeout")}))
+
+	actionError := &sdk.BulkActionError{}
+	actionError.Details = &struct {
+		Errors *[]sdk.BulkActionEntityError `json:"errors,omitempty"`
+	}{Errors: &[]sdk.BulkActionEntityError{{}}}
+
+	entity := &sdk.VersionedLink{}
+	entity.Sys.Id = "entry"
+	entityError := sdk.BulkActionEntityError{Entity: entity}
+	entityError.Error = &struct {
+		Details *map[string]interface{} `json:"details,omitempty"`
+		Message *string                 `json:"message,omitempty"`
+		Sys     *struct {
+			Id *string `json:"id,omitempty"`
+		} `json:"sys,omitempty"`
+	}{
+		Details: &map[string]interface{}{"field": "title"},
+		Message: Pointer("validation failed"),
+	}
+	*actionError.Details.Errors = append(*actionError.Details.Errors, entityError)
+
+	assert.Equal(t, []ActionEntityError{
+		{Message: "unknown error"},
+		{Entity: entity, Message: `validation failed {"field":"title"}`},
+	}, ActionErrors(actionError))
+}
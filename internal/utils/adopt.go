@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AdoptExistingAttribute returns the schema attribute which overrides the
+// adopt_existing setting of the provider for a single resource
+func AdoptExistingAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Description: "Adopt the object when it already exists in Contentful instead of failing on create. " +
+			"The existing object is updated to match the configuration. Overrides the adopt_existing setting of the provider.",
+	}
+}
+
+// ShouldAdopt returns whether an object which already exists should be adopted
+// on create. The setting of the resource takes precedence over the setting of
+// the provider.
+func ShouldAdopt(providerSetting bool, resourceSetting types.Bool) bool {
+	if resourceSetting.IsNull() || resourceSetting.IsUnknown() {
+		return providerSetting
+	}
+
+	return resourceSetting.ValueBool()
+}
+
+// AddAdoptedWarning reports that an existing object was adopted instead of
+// created
+func AddAdoptedWarning(d *diag.Diagnostics, kind string, id string) {
+	d.AddWarning(
+		fmt.Sprintf("Adopted existing %s", kind),
+		fmt.Sprintf("The %s %q already existed and has been adopted, it was updated to match the configuration. "+
+			"Destroying this resource will remove it from Contentful.", kind, id),
+	)
+}
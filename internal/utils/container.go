@@ -5,7 +5,12 @@ import (
 )
 
 type ProviderData struct {
-	Client         *sdk.ClientWithResponses
-	ClientUpload   *sdk.ClientWithResponses
-	OrganizationId string
+	Client           *sdk.ClientWithResponses
+	ClientUpload     *sdk.ClientWithResponses
+	OrganizationId   string
+	Environment      string
+	AdoptExisting    bool
+	OnDestroy        string
+	DefaultTags      []string
+	OmitApiKeyTokens bool
 }
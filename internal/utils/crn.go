@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// SpaceCRNRegex matches the CRN of a space as used in the `source` of the
+// allowed resources of a ResourceLink field.
+var SpaceCRNRegex = regexp.MustCompile(`^crn:contentful:::content:spaces/[a-zA-Z0-9_-]+$`)
+
+// SpaceCRN returns the Contentful Resource Name for the given space id.
+func SpaceCRN(spaceId string) string {
+	return fmt.Sprintf("crn:contentful:::content:spaces/%s", spaceId)
+}
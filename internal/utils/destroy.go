@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// What happens with an entry or asset in Contentful when the resource is
+// destroyed
+const (
+	OnDestroyDelete    = "delete"
+	OnDestroyArchive   = "archive"
+	OnDestroyUnpublish = "unpublish"
+	OnDestroyAbandon   = "abandon"
+)
+
+func GetOnDestroyValues() []string {
+	return []string{OnDestroyDelete, OnDestroyArchive, OnDestroyUnpublish, OnDestroyAbandon}
+}
+
+// OnDestroyDescription describes the on_destroy setting for the given kind of
+// object
+func OnDestroyDescription(kind string) string {
+	return fmt.Sprintf("What to do with the %[1]s in Contentful when the resource is destroyed, one of `%[2]s`. "+
+		"`delete` unpublishes and deletes the %[1]s, `archive` and `unpublish` keep the %[1]s after archiving or "+
+		"unpublishing it and `abandon` leaves the %[1]s as it is. Only `delete` removes the %[1]s from Contentful.",
+		kind, strings.Join(GetOnDestroyValues(), "`, `"))
+}
+
+// OnDestroyAttribute returns the schema attribute which overrides the
+// on_destroy setting of the provider for a single resource
+func OnDestroyAttribute(kind string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Description: OnDestroyDescription(kind) + " Overrides the on_destroy setting of the provider.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(GetOnDestroyValues()...),
+		},
+	}
+}
+
+// OnDestroy returns what to do when a resource is destroyed. The setting of
+// the resource takes precedence over the setting of the provider, which
+// defaults to deleting the object.
+func OnDestroy(providerSetting string, resourceSetting types.String) string {
+	if !resourceSetting.IsNull() && !resourceSetting.IsUnknown() {
+		return resourceSetting.ValueString()
+	}
+
+	if providerSetting != "" {
+		return providerSetting
+	}
+
+	return OnDestroyDelete
+}
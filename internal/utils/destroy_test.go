@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnDestroy(t *testing.T) {
+	assert.Equal(t, OnDestroyDelete, OnDestroy("", types.StringNull()))
+	assert.Equal(t, OnDestroyArchive, OnDestroy(OnDestroyArchive, types.StringNull()))
+	assert.Equal(t, OnDestroyAbandon, OnDestroy(OnDestroyArchive, types.StringValue(OnDestroyAbandon)))
+	assert.Equal(t, OnDestroyUnpublish, OnDestroy("", types.StringValue(OnDestroyUnpublish)))
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// The status of an entry or asset, as shown in the web app
+const (
+	StatusDraft     = "draft"
+	StatusChanged   = "changed"
+	StatusPublished = "published"
+	StatusArchived  = "archived"
+)
+
+// PublishStatus returns the status of an entry or asset. Publishing increments
+// the version, so a published object without changes is exactly one version
+// ahead of the published version.
+func PublishStatus(version int64, publishedVersion *int64, published bool, archived bool) string {
+	switch {
+	case archived:
+		return StatusArchived
+	case !published || publishedVersion == nil:
+		return StatusDraft
+	case version > *publishedVersion+1:
+		return StatusChanged
+	default:
+		return StatusPublished
+	}
+}
+
+// PlanRepublish plans an update of an entry or asset which should be published
+// but has changes since it was last published, so the published content
+// matches the configuration again. The computed publishing attributes are
+// marked as unknown, which makes the plan differ from the state.
+func PlanRepublish(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to republish on create or destroy
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var published types.Bool
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("published"), &published)...)
+
+	var status types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("status"), &status)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !published.ValueBool() || status.ValueString() != StatusChanged {
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("published_version"), types.Int64Unknown())...)
+}
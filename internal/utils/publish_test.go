@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublishStatus(t *testing.T) {
+	assert.Equal(t, StatusDraft, PublishStatus(1, nil, false, false))
+	assert.Equal(t, StatusDraft, PublishStatus(5, Pointer(int64(3)), false, false))
+	assert.Equal(t, StatusPublished, PublishStatus(4, Pointer(int64(3)), true, false))
+	assert.Equal(t, StatusChanged, PublishStatus(5, Pointer(int64(3)), true, false))
+	assert.Equal(t, StatusArchived, PublishStatus(5, Pointer(int64(3)), true, true))
+}
@@ -0,0 +1,220 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-contentful/internal/sdk"
+)
+
+// TagsAttribute returns the schema attribute for the tags of an entry or
+// asset. When the tags are not configured, the tags of the object are kept.
+func TagsAttribute(kind string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:    true,
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("IDs of the tags of the %s. The tags must exist in the same environment. "+
+			"When not set, the tags of the %s are left as they are.", kind, kind),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// TagsAllAttribute returns the schema attribute for all tags of an entry or
+// asset, including the default tags of the provider
+func TagsAllAttribute(kind string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Computed:    true,
+		ElementType: types.StringType,
+		Description: fmt.Sprintf("IDs of all tags of the %s, including the default_tags of the provider.", kind),
+	}
+}
+
+// TagsMetadata returns the metadata with links to the given tags, or nil when
+// the tags are not known
+func TagsMetadata(tags types.Set) *sdk.Metadata {
+	if tags.IsNull() || tags.IsUnknown() {
+		return nil
+	}
+
+	metadata := &sdk.Metadata{
+		Tags: []sdk.SystemPropertiesReference{},
+	}
+	for _, id := range SetStrings(tags) {
+		metadata.Tags = append(metadata.Tags, sdk.SystemPropertiesReference{
+			Sys: sdk.SystemPropertiesLink{
+				Type:     "Link",
+				LinkType: "Tag",
+				Id:       id,
+			},
+		})
+	}
+
+	return metadata
+}
+
+// TagsFromMetadata returns the IDs of the tags in the metadata of an entry or
+// asset
+func TagsFromMetadata(metadata *sdk.Metadata) types.Set {
+	values := []attr.Value{}
+	if metadata != nil {
+		for _, tag := range metadata.Tags {
+			values = append(values, types.StringValue(tag.Sys.Id))
+		}
+	}
+
+	return types.SetValueMust(types.StringType, values)
+}
+
+// ImportTags returns the tags and all tags of an entry or asset from its
+// metadata. The tags which were only added as default tags of the provider
+// are not part of the tags.
+func ImportTags(metadata *sdk.Metadata, tags types.Set, tagsAll types.Set) (types.Set, types.Set) {
+	all := TagsFromMetadata(metadata)
+
+	configured := SetStrings(tags)
+	var defaults []string
+	for _, id := range SetStrings(tagsAll) {
+		if !slices.Contains(configured, id) {
+			defaults = append(defaults, id)
+		}
+	}
+
+	values := []attr.Value{}
+	for _, id := range SetStrings(all) {
+		if !slices.Contains(defaults, id) {
+			values = append(values, types.StringValue(id))
+		}
+	}
+
+	return types.SetValueMust(types.StringType, values), all
+}
+
+// StringsSet returns a set of the given strings
+func StringsSet(values []string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// SetStrings returns the known string values of a set, sorted
+func SetStrings(set types.Set) []string {
+	result := []string{}
+	for _, value := range set.Elements() {
+		if str, ok := value.(types.String); ok && !str.IsUnknown() && !str.IsNull() {
+			result = append(result, str.ValueString())
+		}
+	}
+	slices.Sort(result)
+	return result
+}
+
+// PlanTags plans all tags of an entry or asset, which are the configured tags
+// merged with the default tags of the provider. When the tags are not
+// configured on create, the object only gets the default tags.
+func PlanTags(ctx context.Context, defaults []string, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags, configured types.Set
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root("tags"), &configured)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if tags.IsUnknown() && configured.IsNull() {
+		tags = StringsSet(nil)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags"), tags)...)
+	}
+
+	if tags.IsUnknown() || slices.ContainsFunc(tags.Elements(), attr.Value.IsUnknown) {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...)
+		return
+	}
+
+	all := SetStrings(tags)
+	for _, id := range defaults {
+		if !slices.Contains(all, id) {
+			all = append(all, id)
+		}
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("tags_all"), StringsSet(all))...)
+}
+
+// CheckTagsExist reports the planned tags of an entry or asset which do not
+// exist in its environment. Tags which are not known yet, e.g. because they
+// are created in the same apply, and tags which were already set are not
+// checked. Must be called after PlanTags.
+func CheckTagsExist(ctx context.Context, client *sdk.ClientWithResponses, defaults []string, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if client == nil || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var spaceID, environment types.String
+	var tags types.Set
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("space_id"), &spaceID)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("environment"), &environment)...)
+	response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("tags_all"), &tags)...)
+
+	var current []string
+	if !request.State.Raw.IsNull() {
+		var stateTags types.Set
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("tags_all"), &stateTags)...)
+		current = SetStrings(stateTags)
+	}
+
+	if response.Diagnostics.HasError() || spaceID.IsUnknown() || environment.IsUnknown() {
+		return
+	}
+
+	for _, id := range SetStrings(tags) {
+		if slices.Contains(current, id) {
+			continue
+		}
+
+		resp, err := client.GetTagWithResponse(ctx, spaceID.ValueString(), environment.ValueString(), id)
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("tags"), "Error checking tag", "Could not read tag: "+err.Error())
+			return
+		}
+
+		if resp.StatusCode() == http.StatusNotFound && slices.Contains(defaults, id) {
+			response.Diagnostics.AddError(
+				"Tag not found",
+				fmt.Sprintf("The tag %q of the default_tags of the provider does not exist in environment %q.", id, environment.ValueString()),
+			)
+			continue
+		}
+
+		if resp.StatusCode() == http.StatusNotFound {
+			response.Diagnostics.AddAttributeError(
+				path.Root("tags"),
+				"Tag not found",
+				fmt.Sprintf("The tag %q does not exist in environment %q, create it first or use the id of a contentful_tag resource.", id, environment.ValueString()),
+			)
+			continue
+		}
+
+		if err := CheckClientResponse(resp, err, http.StatusOK); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("tags"), "Error checking tag", "Could not read tag: "+err.Error())
+			return
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagsMetadata(t *testing.T) {
+	assert.Nil(t, TagsMetadata(types.SetNull(types.StringType)))
+	assert.Nil(t, TagsMetadata(types.SetUnknown(types.StringType)))
+
+	tags := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("public"), types.StringValue("governance")})
+	data, err := json.Marshal(TagsMetadata(tags))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"tags": [
+		{"sys": {"type": "Link", "linkType": "Tag", "id": "governance"}},
+		{"sys": {"type": "Link", "linkType": "Tag", "id": "public"}}
+	]}`, string(data))
+
+	assert.True(t, tags.Equal(TagsFromMetadata(TagsMetadata(tags))))
+	assert.Equal(t, types.SetValueMust(types.StringType, []attr.Value{}), TagsFromMetadata(nil))
+}
+
+func TestImportTags(t *testing.T) {
+	metadata := TagsMetadata(StringsSet([]string{"governance", "managedByTerraform", "editorial"}))
+
+	// The default tag is not part of the configured tags
+	tags, all := ImportTags(metadata, StringsSet([]string{"governance"}), StringsSet([]string{"governance", "managedByTerraform"}))
+	assert.Equal(t, []string{"editorial", "governance"}, SetStrings(tags))
+	assert.Equal(t, []string{"editorial", "governance", "managedByTerraform"}, SetStrings(all))
+
+	// A default tag which is also configured is kept
+	tags, _ = ImportTags(metadata, StringsSet([]string{"governance", "managedByTerraform"}), StringsSet([]string{"governance", "managedByTerraform"}))
+	assert.Equal(t, []string{"editorial", "governance", "managedByTerraform"}, SetStrings(tags))
+
+	// Without a previous state all tags are imported
+	tags, _ = ImportTags(metadata, types.SetNull(types.StringType), types.SetNull(types.StringType))
+	assert.Equal(t, []string{"editorial", "governance", "managedByTerraform"}, SetStrings(tags))
+}